@@ -24,10 +24,10 @@ import (
 	"github.com/orange-cloudavenue/common-go/regex"
 )
 
-// ExtractUUID extract the UUID (version 4) found in the input string using a regular expression.
-// Returns the UUID as a string if found, otherwise returns an error.
+// ExtractUUID extract the UUID found in the input string (see regex.UUIDDefault).
+// Returns the UUID in canonical form (lower case) if found, otherwise returns an error.
 func ExtractUUID(input string) (string, error) {
-	matches := regex.UUID4Regex().FindAllString(input, -1)
+	matches := regex.FindUUIDs(input, regex.UUIDDefault)
 	return helperMatch("ExtractUUID", matches, 1)
 }
 
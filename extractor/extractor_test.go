@@ -53,6 +53,18 @@ func TestExtractUUID_Success(t *testing.T) {
 			expected:      "",
 			expectedError: true,
 		},
+		{
+			name:          "Upper case UUID is canonicalized",
+			input:         "prefix D3C42A20-96B9-4452-91DD-F71B71DFE314 suffix",
+			expected:      "d3c42a20-96b9-4452-91dd-f71b71dfe314",
+			expectedError: false,
+		},
+		{
+			name:          "UUID with any variant",
+			input:         "prefix d3c42a20-96b9-4452-c1dd-f71b71dfe314 suffix",
+			expected:      "d3c42a20-96b9-4452-c1dd-f71b71dfe314",
+			expectedError: false,
+		},
 		{
 			name:          "No UUID in input",
 			input:         "this string has no uuid",
@@ -2,4 +2,4 @@ module github.com/orange-cloudavenue/common-go/extractor
 
 go 1.25.0
 
-require github.com/orange-cloudavenue/common-go/regex v1.0.1
+require github.com/orange-cloudavenue/common-go/regex v1.3.0
@@ -4,6 +4,11 @@ go 1.25.0
 
 require (
 	github.com/brianvoe/gofakeit/v7 v7.15.0
-	github.com/orange-cloudavenue/common-go/regex v1.1.1
-	github.com/orange-cloudavenue/common-go/urn v1.2.0
+	github.com/orange-cloudavenue/common-go/regex v1.3.0
+	github.com/orange-cloudavenue/common-go/urn v1.4.0
+)
+
+require (
+	github.com/orange-cloudavenue/common-go/strcase v1.0.0 // indirect
+	golang.org/x/text v0.37.0 // indirect
 )
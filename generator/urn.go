@@ -19,6 +19,8 @@
 package generator
 
 import (
+	"fmt"
+
 	"github.com/brianvoe/gofakeit/v7"
 
 	"github.com/orange-cloudavenue/common-go/urn"
@@ -32,7 +34,7 @@ func init() {
 		Example:     "urn:vcloud:gateway:12345678-1234-4234-1234-123456789012",
 		Output:      "string",
 		Params: []gofakeit.Param{
-			{Field: "urnType", Type: "string", Description: "The type of the URN, e.g., 'edgegateway', 'vdc', etc."},
+			{Field: "urnType", Type: "string", Description: "The type of the URN, e.g., 'edgegateway', 'vdc', 'entity', etc."},
 		},
 		Generate: func(f *gofakeit.Faker, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
 			urnType, _ := info.GetString(m, "urnType")
@@ -42,6 +44,17 @@ func init() {
 				return nil, err
 			}
 
+			switch newURN {
+			case urn.Entity:
+				// Runtime Defined Entity: urn:vcloud:entity:<vendor>:<nss>:<uuid>
+				u, err := urn.NewEntity(f.Regex(`[a-z]{4,10}`), f.Regex(`[a-z]{4,12}`), f.UUID())
+				return u.String(), err
+			case urn.EntityType:
+				// Runtime Defined Entity type: urn:vcloud:type:<vendor>:<nss>:<version>
+				u, err := urn.NewEntityType(f.Regex(`[a-z]{4,10}`), f.Regex(`[a-z]{4,12}`), fmt.Sprintf("%d.%d.%d", f.Number(1, 9), f.Number(0, 9), f.Number(0, 9)))
+				return u.String(), err
+			}
+
 			return urn.Normalize(newURN, f.UUID()).String(), nil
 		},
 	})
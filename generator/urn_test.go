@@ -41,6 +41,26 @@ func TestGenerator_URN(t *testing.T) {
 	}
 }
 
+func TestGenerator_URN_CatalogueTypes(t *testing.T) {
+	type CStruct struct {
+		ProviderVDC     string `fake:"{urn:providerVdc}"`
+		Role            string `fake:"{urn:role}"`
+		ExternalNetwork string `fake:"{urn:externalNetwork}"`
+		IPSet           string `fake:"{urn:ipSet}"`
+	}
+
+	var st CStruct
+
+	err := Struct(&st)
+	if err != nil {
+		t.Fatalf("Failed to create template: %v", err)
+	}
+
+	if !urn.IsProviderVDC(st.ProviderVDC) || !urn.IsRole(st.Role) || !urn.IsExternalNetwork(st.ExternalNetwork) || !urn.IsIPSet(st.IPSet) {
+		t.Fatalf("Expected URNs of the requested types, got %+v", st)
+	}
+}
+
 func TestGenerator_URN_NoURNType(t *testing.T) {
 	type CStruct struct {
 		ID string `fake:"{urn}"`
@@ -53,3 +73,25 @@ func TestGenerator_URN_NoURNType(t *testing.T) {
 		t.Fatal("Expected error when no URN type is provided, but got none")
 	}
 }
+
+func TestGenerator_URN_Entity(t *testing.T) {
+	type CStruct struct {
+		Entity     string `fake:"{urn:entity}"`
+		EntityType string `fake:"{urn:entityType}"`
+	}
+
+	var st CStruct
+
+	err := Struct(&st)
+	if err != nil {
+		t.Fatalf("Failed to create template: %v", err)
+	}
+
+	if !urn.IsEntity(st.Entity) {
+		t.Fatalf("Expected URN to be of type Entity, got %s", st.Entity)
+	}
+
+	if !urn.IsEntityType(st.EntityType) {
+		t.Fatalf("Expected URN to be of type EntityType, got %s", st.EntityType)
+	}
+}
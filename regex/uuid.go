@@ -0,0 +1,124 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package regex
+
+import (
+	"strings"
+)
+
+// UUIDMode selects the UUIDs accepted by ParseUUID, IsUUID and FindUUIDs.
+// The version modes can be combined (e.g. UUIDVersion4|UUIDVersion7) and
+// combined with UUIDIgnoreCase.
+type UUIDMode uint8
+
+const (
+	// UUIDAnyVersion accepts the RFC 4122/9562 UUIDs of any version (1 to 8) with the RFC variant.
+	UUIDAnyVersion UUIDMode = 1 << iota
+	// UUIDVersion4 accepts the random UUIDs (version 4).
+	UUIDVersion4
+	// UUIDVersion7 accepts the time-ordered UUIDs (version 7).
+	UUIDVersion7
+	// UUIDIgnoreCase accepts upper-case hexadecimal digits.
+	// The UUID returned by ParseUUID and FindUUIDs is canonicalized to lower case.
+	UUIDIgnoreCase
+	// UUIDAnyLayout accepts any UUID layout (8-4-4-4-12 hexadecimal digits)
+	// without checking the version and the variant.
+	UUIDAnyLayout
+
+	// UUIDDefault is the mode shared by the urn, extractor and utils packages:
+	// any UUID layout, case-insensitive. The version and the variant are not checked,
+	// so that the identifiers accepted by the previous versions are still accepted;
+	// use UUIDAnyVersion, UUIDVersion4 or UUIDVersion7 for the strict checks.
+	UUIDDefault = UUIDAnyLayout | UUIDIgnoreCase
+)
+
+// UUIDLayoutRegexString matches the layout of a UUID (8-4-4-4-12 hexadecimal digits)
+// without checking the version and the variant.
+const UUIDLayoutRegexString = `[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`
+
+// UUIDLayoutRegex returns the compiled UUIDLayoutRegexString.
+var UUIDLayoutRegex = lazyRegexCompile(UUIDLayoutRegexString)
+
+// ParseUUID checks the UUID against the mode and returns its canonical form (lower case).
+// Returns false if the UUID is not accepted by the mode.
+func ParseUUID(uuid string, mode UUIDMode) (string, bool) {
+	if len(uuid) != 36 {
+		return "", false
+	}
+
+	upper := false
+	for i := 0; i < len(uuid); i++ {
+		c := uuid[i]
+		switch {
+		case i == 8 || i == 13 || i == 18 || i == 23:
+			if c != '-' {
+				return "", false
+			}
+		case '0' <= c && c <= '9', 'a' <= c && c <= 'f':
+		case 'A' <= c && c <= 'F':
+			upper = true
+		default:
+			return "", false
+		}
+	}
+
+	if upper {
+		if mode&UUIDIgnoreCase == 0 {
+			return "", false
+		}
+		uuid = strings.ToLower(uuid)
+	}
+
+	if mode&UUIDAnyLayout != 0 {
+		return uuid, true
+	}
+
+	// The variant is the 2 most significant bits of the 17th digit (10xx).
+	if v := uuid[19]; v != '8' && v != '9' && v != 'a' && v != 'b' {
+		return "", false
+	}
+
+	switch version := uuid[14]; {
+	case mode&UUIDAnyVersion != 0 && '1' <= version && version <= '8':
+	case mode&UUIDVersion4 != 0 && version == '4':
+	case mode&UUIDVersion7 != 0 && version == '7':
+	default:
+		return "", false
+	}
+
+	return uuid, true
+}
+
+// IsUUID returns true if the UUID is accepted by the mode.
+func IsUUID(uuid string, mode UUIDMode) bool {
+	_, ok := ParseUUID(uuid, mode)
+	return ok
+}
+
+// FindUUIDs returns the UUIDs accepted by the mode found in the input, in canonical form.
+func FindUUIDs(input string, mode UUIDMode) []string {
+	var uuids []string
+	for _, match := range UUIDLayoutRegex().FindAllString(input, -1) {
+		if uuid, ok := ParseUUID(match, mode); ok {
+			uuids = append(uuids, uuid)
+		}
+	}
+
+	return uuids
+}
@@ -0,0 +1,158 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package regex
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseUUID(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		mode   UUIDMode
+		want   string
+		wantOK bool
+	}{
+		{
+			name:   "Version4AnyVersion",
+			input:  "d3c42a20-96b9-4452-91dd-f71b71dfe314",
+			mode:   UUIDAnyVersion,
+			want:   "d3c42a20-96b9-4452-91dd-f71b71dfe314",
+			wantOK: true,
+		},
+		{
+			name:   "Version1AnyVersion",
+			input:  "123e4567-e89b-12d3-a456-426614174000",
+			mode:   UUIDAnyVersion,
+			want:   "123e4567-e89b-12d3-a456-426614174000",
+			wantOK: true,
+		},
+		{
+			name:   "Version1Version4",
+			input:  "123e4567-e89b-12d3-a456-426614174000",
+			mode:   UUIDVersion4,
+			wantOK: false,
+		},
+		{
+			name:   "Version7",
+			input:  "01890a5d-ac96-774b-bcce-b302099a8057",
+			mode:   UUIDVersion7,
+			want:   "01890a5d-ac96-774b-bcce-b302099a8057",
+			wantOK: true,
+		},
+		{
+			name:   "Version7Version4Or7",
+			input:  "01890a5d-ac96-774b-bcce-b302099a8057",
+			mode:   UUIDVersion4 | UUIDVersion7,
+			want:   "01890a5d-ac96-774b-bcce-b302099a8057",
+			wantOK: true,
+		},
+		{
+			name:   "InvalidVariant",
+			input:  "12345678-1234-4234-1234-123456789012",
+			mode:   UUIDAnyVersion,
+			wantOK: false,
+		},
+		{
+			name:   "InvalidVersion",
+			input:  "12345678-1234-0234-9234-123456789012",
+			mode:   UUIDAnyVersion,
+			wantOK: false,
+		},
+		{
+			name:   "UpperCase",
+			input:  "D3C42A20-96B9-4452-91DD-F71B71DFE314",
+			mode:   UUIDAnyVersion,
+			wantOK: false,
+		},
+		{
+			name:   "UpperCaseIgnoreCase",
+			input:  "D3C42A20-96B9-4452-91DD-F71B71DFE314",
+			mode:   UUIDDefault,
+			want:   "d3c42a20-96b9-4452-91dd-f71b71dfe314",
+			wantOK: true,
+		},
+		{
+			name:   "InvalidVariantDefault",
+			input:  "12345678-1234-1234-1234-123456789012",
+			mode:   UUIDDefault,
+			want:   "12345678-1234-1234-1234-123456789012",
+			wantOK: true,
+		},
+		{
+			name:   "UpperCaseAnyLayout",
+			input:  "12345678-ABCD-1234-1234-123456789012",
+			mode:   UUIDAnyLayout,
+			wantOK: false,
+		},
+		{
+			name:   "InvalidCharacter",
+			input:  "d3c42a20-96b9-4452-91dd-f71b71dfe31Z",
+			mode:   UUIDDefault,
+			wantOK: false,
+		},
+		{
+			name:   "InvalidLayout",
+			input:  "d3c42a2096b9-4452-91dd-f71b71dfe3141",
+			mode:   UUIDDefault,
+			wantOK: false,
+		},
+		{
+			name:   "Empty",
+			input:  "",
+			mode:   UUIDDefault,
+			wantOK: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := ParseUUID(test.input, test.mode)
+			if ok != test.wantOK || got != test.want {
+				t.Errorf("ParseUUID() = %q, %v, want %q, %v", got, ok, test.want, test.wantOK)
+			}
+			if IsUUID(test.input, test.mode) != test.wantOK {
+				t.Errorf("IsUUID() = %v, want %v", !test.wantOK, test.wantOK)
+			}
+		})
+	}
+}
+
+func TestFindUUIDs(t *testing.T) {
+	input := "vm D3C42A20-96B9-4452-91DD-F71B71DFE314 in vdc 123e4567-e89b-12d3-a456-426614174000 (not 12345678-1234-4234-1234-123456789012)"
+
+	got := FindUUIDs(input, UUIDDefault)
+	want := []string{"d3c42a20-96b9-4452-91dd-f71b71dfe314", "123e4567-e89b-12d3-a456-426614174000", "12345678-1234-4234-1234-123456789012"}
+	if !slices.Equal(got, want) {
+		t.Errorf("FindUUIDs() = %v, want %v", got, want)
+	}
+
+	got = FindUUIDs(input, UUIDAnyVersion|UUIDIgnoreCase)
+	want = want[:2]
+	if !slices.Equal(got, want) {
+		t.Errorf("FindUUIDs() = %v, want %v", got, want)
+	}
+
+	got = FindUUIDs(input, UUIDVersion4)
+	if len(got) != 0 {
+		t.Errorf("FindUUIDs() = %v, want no UUID", got)
+	}
+}
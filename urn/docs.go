@@ -30,12 +30,90 @@
 //
 // Example:
 //
-//	urn := urn.VM.String() + "12345678-1234-1234-1234-123456789012"
-//	if urn.IsVM() {
-//	    uuid := urn.extractUUIDv4(urn.VM)
+//	u := urn.URN(urn.VM.String() + "12345678-1234-4234-9234-123456789012")
+//	if u.IsVM() {
+//	    uuid := u.ID()
 //	    // Use the uuid...
 //	}
 //
+// Some VCD objects share a URN type: the org VDC, external and vApp networks are
+// Network URNs, and the IP sets are SecurityGroup URNs. IsOrgVDCNetwork, IsExternalNetwork,
+// IsVAppNetwork and IsIPSet are provided for readability and check the shared type.
+//
+// To get all the components of a URN (namespace, type and UUID) use Parse.
+// The returned error can be inspected with errors.Is against ErrEmpty,
+// ErrMissingPrefix, ErrUnknownType and ErrInvalidUUID:
+//
+//	p, err := urn.Parse("urn:vcloud:vdc:12345678-1234-4234-9234-123456789012")
+//	if errors.Is(err, urn.ErrUnknownType) {
+//	    // ...
+//	}
+//	fmt.Println(p.Namespace, p.TypeName, p.ID) // vcloud vdc 12345678-...
+//
+// New, NewV7 and NewFromName build a URN with a new identifier: random (UUID version 4),
+// time-ordered (UUID version 7) or derived from a namespace and a name (UUID version 5),
+// so that the same external object always gets the same URN:
+//
+//	id, err := urn.NewFromName(urn.VDC, importNamespace, "my-vdc")
+//
+// Runtime Defined Entities use composite URNs (urn:vcloud:entity:<vendor>:<nss>:<uuid>
+// and urn:vcloud:type:<vendor>:<nss>:<version>). They are built with NewEntity and
+// NewEntityType, and Parse returns their Vendor, NSS and Version.
+//
+// FromHref, URN.CloudAPIHref and URN.LegacyHref convert between URNs and VCD API
+// hrefs, including the legacy vm-<uuid> and vapp-<uuid> identifiers.
+//
+// ParentsOf and ChildrenOf describe the containment hierarchy between the URN
+// types (e.g. a VM lives in a vApp, a vApp in a VDC). TopologicalOrder,
+// SortForCreation and SortForDeletion order types and URNs accordingly.
+//
+// Set is a collection of unique URNs with Union, Intersection, Difference,
+// GroupByType and a deterministic order (by type, then UUID) for Sorted and All.
+//
+// ParsePattern compiles rules such as urn:vcloud:vm:*, urn:vcloud:{vdc,vdcGroup}:*
+// or an exact URN, validated against the registered types. Patterns reports which
+// pattern matched a URN:
+//
+//	rules, err := urn.ParsePatterns("urn:vcloud:vm:*", "urn:vcloud:{vdc,vdcGroup}:*")
+//	if p, ok := rules.Match(u); ok {
+//	    fmt.Println("matched by", p)
+//	}
+//
+// NewScanner finds every URN and bare UUID of an io.Reader (e.g. a log file) with
+// its offset, line, column and URN type, using a bounded amount of memory.
+//
+// URN and Of implement slog.LogValuer. SetRedactionPolicy selects how the URNs are
+// logged: RedactionFull (default), RedactionTypeOnly (urn:vcloud:vdc:***) or
+// RedactionPseudonym (HMAC-keyed, stable pseudonyms). Redact applies the same policy
+// to every URN and UUID of a free text.
+//
+// Reference is the CloudAPI {"name": ..., "id": "urn:vcloud:..."} reference, compared by ID
+// and validated against expected types with Reference.Validate; ReferenceOf is its typed form.
+//
+// Info returns the metadata of a URN type (display name, plural, CloudAPI collection
+// path, legacy XML media type and Terraform resource) and All lists it for all the types.
+//
+// Custom URN types can be added with Register. A registered type is supported by
+// IsValid, Parse, FindURNTypeFromString, NormalizeStrict and the `urn` validator:
+//
+//	urn.MustRegister("backup", "urn:cloudavenue:backup:", urn.WithDisplayName("Backup"))
+//
+// URN implements encoding.TextMarshaler, encoding.TextUnmarshaler, json.Marshaler,
+// json.Unmarshaler, sql.Scanner and driver.Valuer. The decoded values are
+// validated; use Decode with WithBareUUID to accept legacy values containing
+// only the UUID:
+//
+//	u, err := urn.Decode("12345678-1234-4234-9234-123456789012", urn.WithBareUUID(urn.VDC))
+//
+// Use Of to require a URN of a given type at compile time:
+//
+//	func GetVDC(id urn.Of[urn.VDCKind]) (*VDC, error)
+//
+//	id, err := urn.From[urn.VDCKind]("urn:vcloud:vdc:12345678-1234-4234-9234-123456789012")
+//
+// Lenient is the Of to use in the legacy JSON payloads and database rows: it also
+// decodes the bare UUIDs, as WithBareUUID does.
+//
 // Thread safety:
 // All exported functions are safe for concurrent use by multiple goroutines.
 package urn
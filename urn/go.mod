@@ -3,3 +3,10 @@ module github.com/orange-cloudavenue/common-go/urn
 go 1.25.0
 
 exclude github.com/orange-cloudavenue/common-go/urn v1.3.0
+
+require (
+	github.com/orange-cloudavenue/common-go/regex v1.3.0
+	github.com/orange-cloudavenue/common-go/strcase v1.0.0
+)
+
+require golang.org/x/text v0.37.0 // indirect
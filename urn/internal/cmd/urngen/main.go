@@ -0,0 +1,296 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Command urngen generates the URN types of the urn package from catalogue.json:
+// the constants, builtinTypes, URNs, URNByNames, the Kind types, the IsXxx functions
+// and methods, and their tests.
+//
+// Usage (from the urn directory, see go generate):
+//
+//	go run ./internal/cmd/urngen -catalogue catalogue.json -output .
+package main
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var templatesFS embed.FS
+
+// header is the header of the generated files.
+const header = `/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Code generated by urngen from catalogue.json. DO NOT EDIT.
+
+`
+
+// outputs maps the templates to the generated files.
+var outputs = map[string]string{
+	"catalogue.go.tmpl":       "urn_catalogue.go",
+	"kinds.go.tmpl":           "urn_kinds.go",
+	"is_funcs.go.tmpl":        "urn_is_funcs.go",
+	"is_funcs_test.go.tmpl":   "urn_is_funcs_test.go",
+	"is_methods.go.tmpl":      "urn_is_methods.go",
+	"is_methods_test.go.tmpl": "urn_is_methods_test.go",
+}
+
+// Catalogue is the declarative list of the URN types of the urn package.
+type Catalogue struct {
+	// Namespaces maps the URN namespaces to the name of their prefix constant.
+	Namespaces map[string]string `json:"namespaces"`
+	Types      []Type            `json:"types"`
+}
+
+// Type is a URN type of the catalogue.
+type Type struct {
+	// Const is the name of the Go constant (e.g. EdgeGateway).
+	Const string `json:"const"`
+	// Name is the canonical name registered in the urn package (e.g. edgegateway).
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// Segment is the type segment of the prefix (e.g. gateway for urn:vcloud:gateway:).
+	Segment string `json:"segment"`
+	// Section groups the constants in the generated const block.
+	Section string `json:"section"`
+	// Doc is an optional comment of the constant.
+	Doc         string   `json:"doc,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Aliases     []string `json:"aliases,omitempty"`
+	// Helpers are the additional IsXxx helpers of the objects which share the URN type.
+	Helpers           []Helper `json:"helpers,omitempty"`
+	MediaType         string   `json:"mediaType,omitempty"`
+	TerraformResource string   `json:"terraformResource,omitempty"`
+	Routes            Routes   `json:"routes"`
+	// Parents are the constants of the parent URN types.
+	Parents []string `json:"parents,omitempty"`
+	// Format is the format of the identifier: "" (UUID), "entity" or "entityType".
+	Format string `json:"format,omitempty"`
+}
+
+// Helper is an additional IsXxx helper of a URN type (e.g. IsExternalNetwork for Network).
+type Helper struct {
+	// Name is the name of the helper without the Is prefix (e.g. ExternalNetwork).
+	Name string `json:"name"`
+	// Objects are the objects identified by the URN type, used in the comment (e.g. external networks).
+	Objects string `json:"objects"`
+}
+
+// Routes describes where the URN type is exposed in the VCD API.
+type Routes struct {
+	CloudAPI       string `json:"cloudAPI,omitempty"`
+	Legacy         string `json:"legacy,omitempty"`
+	LegacyIDPrefix string `json:"legacyIDPrefix,omitempty"`
+}
+
+var (
+	constRegex   = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+	segmentRegex = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+	formats      = map[string]string{"": "formatUUID", "entity": "formatEntity", "entityType": "formatEntityType"}
+)
+
+func main() {
+	cataloguePath := flag.String("catalogue", "catalogue.json", "path of the catalogue")
+	output := flag.String("output", ".", "directory of the generated files")
+	flag.Parse()
+
+	c, err := Load(*cataloguePath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	files, err := Generate(c)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(*output, name), content, 0o600); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// Load reads and validates the catalogue.
+func Load(path string) (Catalogue, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Catalogue{}, err
+	}
+
+	var c Catalogue
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&c); err != nil {
+		return Catalogue{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if err := c.Validate(); err != nil {
+		return Catalogue{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return c, nil
+}
+
+// Validate checks that the catalogue can be generated.
+func (c Catalogue) Validate() error {
+	var errs []error
+	consts := make(map[string]bool)
+	names := make(map[string]bool)
+	prefixes := make(map[string]bool)
+
+	for i, t := range c.Types {
+		if !constRegex.MatchString(t.Const) {
+			errs = append(errs, fmt.Errorf("type %d: invalid const %q", i, t.Const))
+		}
+		if consts[t.Const] {
+			errs = append(errs, fmt.Errorf("type %s: duplicate const", t.Const))
+		}
+		consts[t.Const] = true
+
+		if t.Name == "" || names[strings.ToLower(t.Name)] {
+			errs = append(errs, fmt.Errorf("type %s: empty or duplicate name %q", t.Const, t.Name))
+		}
+		names[strings.ToLower(t.Name)] = true
+
+		if _, ok := c.Namespaces[t.Namespace]; !ok {
+			errs = append(errs, fmt.Errorf("type %s: unknown namespace %q", t.Const, t.Namespace))
+		}
+		if !segmentRegex.MatchString(t.Segment) {
+			errs = append(errs, fmt.Errorf("type %s: invalid segment %q", t.Const, t.Segment))
+		}
+		if prefix := t.Namespace + ":" + t.Segment; prefixes[prefix] {
+			errs = append(errs, fmt.Errorf("type %s: duplicate prefix urn:%s:", t.Const, prefix))
+		} else {
+			prefixes[prefix] = true
+		}
+
+		for _, h := range t.Helpers {
+			if !constRegex.MatchString(h.Name) || h.Objects == "" {
+				errs = append(errs, fmt.Errorf("type %s: invalid helper %q", t.Const, h.Name))
+			}
+		}
+
+		if t.DisplayName == "" {
+			errs = append(errs, fmt.Errorf("type %s: empty display name", t.Const))
+		}
+		if _, ok := formats[t.Format]; !ok {
+			errs = append(errs, fmt.Errorf("type %s: unknown format %q", t.Const, t.Format))
+		}
+	}
+
+	helpers := make(map[string]bool)
+	for _, t := range c.Types {
+		for _, p := range t.Parents {
+			if !consts[p] {
+				errs = append(errs, fmt.Errorf("type %s: unknown parent %q", t.Const, p))
+			}
+		}
+		for _, h := range t.Helpers {
+			if consts[h.Name] || helpers[h.Name] {
+				errs = append(errs, fmt.Errorf("type %s: duplicate helper %q", t.Const, h.Name))
+			}
+			helpers[h.Name] = true
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Generate returns the content of the generated files indexed by file name.
+func Generate(c Catalogue) (map[string][]byte, error) {
+	tmpl, err := template.New("").Funcs(template.FuncMap{
+		"article":   article,
+		"format":    func(f string) string { return formats[f] },
+		"sampleID":  sampleID,
+		"other":     func(i int) Type { return c.Types[(i+1)%len(c.Types)] },
+		"prefixOf":  func(ns string) string { return c.Namespaces[ns] },
+		"quote":     func(s string) string { return fmt.Sprintf("%q", s) },
+		"quoteList": quoteList,
+		"join":      strings.Join,
+	}).ParseFS(templatesFS, "templates/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte, len(outputs))
+	for name, output := range outputs {
+		var buf bytes.Buffer
+		buf.WriteString(header)
+		if err := tmpl.ExecuteTemplate(&buf, name, c); err != nil {
+			return nil, err
+		}
+
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", output, err)
+		}
+		files[output] = src
+	}
+
+	return files, nil
+}
+
+// article returns the indefinite article of the word ("a" or "an").
+func article(word string) string {
+	if word != "" && strings.ContainsRune("AEIOU", rune(word[0])) {
+		return "an"
+	}
+
+	return "a"
+}
+
+// sampleID returns the Go expression of a valid identifier for the format.
+func sampleID(format string) string {
+	switch format {
+	case "entity":
+		return `"vmware:tkgcluster:" + validUUIDv4`
+	case "entityType":
+		return `"vmware:tkgcluster:1.0.0"`
+	default:
+		return "validUUIDv4"
+	}
+}
+
+// quoteList returns the Go expression of a list of strings.
+func quoteList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+
+	return strings.Join(quoted, ", ")
+}
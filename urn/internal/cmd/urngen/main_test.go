@@ -0,0 +1,122 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// urnDir is the directory of the urn package, relative to this package.
+const urnDir = "../../.."
+
+// TestGeneratedFilesUpToDate fails if the generated files do not match catalogue.json.
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	c, err := Load(filepath.Join(urnDir, "catalogue.json"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	files, err := Generate(c)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	for name, want := range files {
+		got, err := os.ReadFile(filepath.Join(urnDir, name))
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is not up to date, run go generate in the urn directory", name)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	c := Catalogue{
+		Namespaces: map[string]string{"vcloud": "VcloudPrefix"},
+		Types: []Type{
+			{Const: "Org", Name: "org", Namespace: "vcloud", Segment: "org", Section: "VCD", DisplayName: "Organization"},
+			{
+				Const: "VDC", Name: "vdc", Namespace: "vcloud", Segment: "vdc", Section: "VCD", DisplayName: "VDC", Parents: []string{"Org"},
+				Helpers: []Helper{{Name: "OrgVDC", Objects: "org VDCs"}},
+			},
+		},
+	}
+
+	files, err := Generate(c)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	for file, want := range map[string][]string{
+		"urn_catalogue.go":       {`VDC = URN(VcloudPrefix + "vdc:")`, `Parents:     []URN{Org},`, `"vdc": VDC,`},
+		"urn_kinds.go":           {"VDCKind struct{}", "func (VDCKind) URNType() URN {"},
+		"urn_is_funcs.go":        {"// IsOrg returns true if the URN is an Org URN.", "func IsVDC(urn string) bool {", "// the URN type of the org VDCs.\nfunc IsOrgVDC(urn string) bool {"},
+		"urn_is_methods.go":      {"// IsVDC returns true if the URN is a VDC URN.", "func (urn URN) IsVDC() bool {", "func (urn URN) IsOrgVDC() bool {"},
+		"urn_is_funcs_test.go":   {"func TestIsVDC(t *testing.T) {", "urn:  Org.String() + validUUIDv4,", "VDC: IsVDC,", "if got := IsOrgVDC(tt.urn); got != tt.want {"},
+		"urn_is_methods_test.go": {"func TestURN_IsVDC(t *testing.T) {", "Code generated by urngen", "VDC: URN.IsVDC,"},
+	} {
+		for _, w := range want {
+			if !strings.Contains(string(files[file]), w) {
+				t.Errorf("%s does not contain %q", file, w)
+			}
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := Type{Const: "Org", Name: "org", Namespace: "vcloud", Segment: "org", DisplayName: "Organization"}
+
+	tests := []struct {
+		name    string
+		mutate  func(*Type)
+		wantErr string
+	}{
+		{name: "InvalidConst", mutate: func(t *Type) { t.Const = "org" }, wantErr: "invalid const"},
+		{name: "UnknownNamespace", mutate: func(t *Type) { t.Namespace = "unknown" }, wantErr: "unknown namespace"},
+		{name: "InvalidSegment", mutate: func(t *Type) { t.Segment = "or:g" }, wantErr: "invalid segment"},
+		{name: "EmptyDisplayName", mutate: func(t *Type) { t.DisplayName = "" }, wantErr: "empty display name"},
+		{name: "UnknownFormat", mutate: func(t *Type) { t.Format = "unknown" }, wantErr: "unknown format"},
+		{name: "UnknownParent", mutate: func(t *Type) { t.Parents = []string{"VDC"} }, wantErr: "unknown parent"},
+		{name: "InvalidHelper", mutate: func(t *Type) { t.Helpers = []Helper{{Name: "orgVDC", Objects: "org VDCs"}} }, wantErr: "invalid helper"},
+		{name: "DuplicateHelper", mutate: func(t *Type) { t.Helpers = []Helper{{Name: "Org", Objects: "orgs"}} }, wantErr: "duplicate helper"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			typ := valid
+			tt.mutate(&typ)
+			err := Catalogue{Namespaces: map[string]string{"vcloud": "VcloudPrefix"}, Types: []Type{typ}}.Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	dup := Catalogue{Namespaces: map[string]string{"vcloud": "VcloudPrefix"}, Types: []Type{valid, valid}}
+	if err := dup.Validate(); err == nil || !strings.Contains(err.Error(), "duplicate") {
+		t.Errorf("Validate() error = %v, want duplicates", err)
+	}
+}
@@ -19,101 +19,21 @@
 package urn
 
 import (
-	"regexp"
 	"strings"
+
+	"github.com/orange-cloudavenue/common-go/regex"
 )
 
 const (
 	// Prefixes is the list of prefixes.
 	VcloudPrefix      = "urn:vcloud:"
 	CloudAvenuePrefix = "urn:cloudavenue:"
-
-	// * VCD.
-	Org                        = URN(VcloudPrefix + "org:")
-	VM                         = URN(VcloudPrefix + "vm:")
-	User                       = URN(VcloudPrefix + "user:")
-	Group                      = URN(VcloudPrefix + "group:")
-	EdgeGateway                = URN(VcloudPrefix + "gateway:")
-	VDC                        = URN(VcloudPrefix + "vdc:")
-	VDCGroup                   = URN(VcloudPrefix + "vdcGroup:")
-	VDCComputePolicy           = URN(VcloudPrefix + "vdcComputePolicy:")
-	Network                    = URN(VcloudPrefix + "network:")
-	VDCStorageProfile          = URN(VcloudPrefix + "vdcstorageProfile:")
-	VAPP                       = URN(VcloudPrefix + "vapp:")
-	VAPPTemplate               = URN(VcloudPrefix + "vappTemplate:")
-	Disk                       = URN(VcloudPrefix + "disk:")
-	SecurityGroup              = URN(VcloudPrefix + "firewallGroup:")
-	Catalog                    = URN(VcloudPrefix + "catalog:")
-	Token                      = URN(VcloudPrefix + "token:")
-	AppPortProfile             = URN(VcloudPrefix + "applicationPortProfile:")
-	CertificateLibraryItem     = URN(VcloudPrefix + "certificateLibraryItem:")
-	LoadBalancerPool           = URN(VcloudPrefix + "loadBalancerPool:")
-	LoadBalancerVirtualService = URN(VcloudPrefix + "loadBalancerVirtualService:")
-	ServiceEngineGroup         = URN(VcloudPrefix + "serviceEngineGroup:")
-	Site                       = URN(VcloudPrefix + "site:")
-
-	// * CLOUDAVENUE.
-	VCDA = URN(CloudAvenuePrefix + "vcda:")
 )
 
-var URNs = []URN{
-	Org,
-	VM,
-	User,
-	Group,
-	EdgeGateway,
-	VDC,
-	VDCGroup,
-	VDCComputePolicy,
-	Network,
-	VDCStorageProfile,
-	VAPP,
-	VAPPTemplate,
-	Disk,
-	SecurityGroup,
-	Catalog,
-	Token,
-	AppPortProfile,
-	CertificateLibraryItem,
-	LoadBalancerPool,
-	LoadBalancerVirtualService,
-	ServiceEngineGroup,
-	VCDA,
-	Site,
-}
-
-var URNByNames = map[string]URN{
-	"org":                        Org,
-	"vm":                         VM,
-	"user":                       User,
-	"group":                      Group,
-	"edgegateway":                EdgeGateway,
-	"vdc":                        VDC,
-	"vdcGroup":                   VDCGroup,
-	"vdcComputePolicy":           VDCComputePolicy,
-	"network":                    Network,
-	"vdcstorageProfile":          VDCStorageProfile,
-	"vapp":                       VAPP,
-	"vappTemplate":               VAPPTemplate,
-	"disk":                       Disk,
-	"firewallGroup":              SecurityGroup,
-	"catalog":                    Catalog,
-	"token":                      Token,
-	"applicationPortProfile":     AppPortProfile,
-	"certificateLibraryItem":     CertificateLibraryItem,
-	"loadBalancerPool":           LoadBalancerPool,
-	"loadBalancerVirtualService": LoadBalancerVirtualService,
-	"serviceEngineGroup":         ServiceEngineGroup,
-	"vcda":                       VCDA,
-	"site":                       Site,
-}
-
 type (
 	URN string
 )
 
-var uuidRegex = regexp.MustCompile(`^([a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12})$`)
-
 // String returns the string representation of the URN.
 func (urn URN) String() string {
 	return string(urn)
@@ -125,7 +45,12 @@ func (urn URN) IsType(prefix URN) bool {
 		return false
 	}
 
-	return strings.HasPrefix(string(urn), prefix.String()) && isUUIDV4(urn.extractUUIDv4(prefix))
+	if !strings.HasPrefix(string(urn), prefix.String()) {
+		return false
+	}
+
+	_, err := formatOf(prefix).parse(urn.extractUUIDv4(prefix))
+	return err == nil
 }
 
 // isEmpty returns true if the URN is empty.
@@ -133,19 +58,35 @@ func (urn URN) isEmpty() bool {
 	return len(urn) == 0
 }
 
-func isUUIDV4(urn string) bool {
-	return uuidRegex.MatchString(urn)
+// isUUID returns true if the string is a UUID accepted by the URNs
+// (any UUID layout, case-insensitive, see regex.UUIDDefault).
+func isUUID(uuid string) bool {
+	return regex.IsUUID(uuid, regex.UUIDDefault)
 }
 
-// IsUUIDV4 checks if the provided string matches the UUIDv4 format.
+// IsUUIDV4 checks if the provided string matches the UUIDv4 format
+// (8-4-4-4-12 lower-case hexadecimal digits).
 // Returns true if the string is a valid UUIDv4, otherwise false.
+// Use IsStrictUUIDV4 to check the version and the variant digits.
 func IsUUIDV4(urn string) bool {
-	return isUUIDV4(urn)
+	return regex.IsUUID(urn, regex.UUIDAnyLayout)
 }
 
-// ContainsPrefix returns true if the URN contains any prefix.
+// IsStrictUUIDV4 checks if the provided string is a version 4 UUID in lower case,
+// like regex.UUID4RegexString: the version and the variant digits are checked.
+// Returns true if the string is a valid UUIDv4, otherwise false.
+func IsStrictUUIDV4(urn string) bool {
+	return regex.IsUUID(urn, regex.UUIDVersion4)
+}
+
+// ContainsPrefix returns true if the URN contains the prefix of any registered namespace.
 func (urn URN) ContainsPrefix() bool {
-	return strings.Contains(string(urn), string(VcloudPrefix)) || strings.Contains(string(urn), string(CloudAvenuePrefix))
+	for _, ns := range defaultRegistry.namespaces() {
+		if strings.Contains(string(urn), "urn:"+ns+":") {
+			return true
+		}
+	}
+	return false
 }
 
 // extractUUIDv4 returns the UUIDv4 from the URN.
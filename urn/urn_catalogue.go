@@ -0,0 +1,401 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Code generated by urngen from catalogue.json. DO NOT EDIT.
+
+package urn
+
+const (
+	// * VCD.
+	Org              = URN(VcloudPrefix + "org:")
+	VM               = URN(VcloudPrefix + "vm:")
+	User             = URN(VcloudPrefix + "user:")
+	Group            = URN(VcloudPrefix + "group:")
+	EdgeGateway      = URN(VcloudPrefix + "gateway:")
+	VDC              = URN(VcloudPrefix + "vdc:")
+	VDCGroup         = URN(VcloudPrefix + "vdcGroup:")
+	VDCComputePolicy = URN(VcloudPrefix + "vdcComputePolicy:")
+	// Network is urn:vcloud:network:<uuid>. VCD uses it for the org VDC, external and vApp networks.
+	Network           = URN(VcloudPrefix + "network:")
+	VDCStorageProfile = URN(VcloudPrefix + "vdcstorageProfile:")
+	VAPP              = URN(VcloudPrefix + "vapp:")
+	VAPPTemplate      = URN(VcloudPrefix + "vappTemplate:")
+	Disk              = URN(VcloudPrefix + "disk:")
+	// SecurityGroup is urn:vcloud:firewallGroup:<uuid>. VCD uses it for the security groups and the IP sets.
+	SecurityGroup              = URN(VcloudPrefix + "firewallGroup:")
+	Catalog                    = URN(VcloudPrefix + "catalog:")
+	Token                      = URN(VcloudPrefix + "token:")
+	AppPortProfile             = URN(VcloudPrefix + "applicationPortProfile:")
+	CertificateLibraryItem     = URN(VcloudPrefix + "certificateLibraryItem:")
+	LoadBalancerPool           = URN(VcloudPrefix + "loadBalancerPool:")
+	LoadBalancerVirtualService = URN(VcloudPrefix + "loadBalancerVirtualService:")
+	ServiceEngineGroup         = URN(VcloudPrefix + "serviceEngineGroup:")
+	Site                       = URN(VcloudPrefix + "site:")
+	ProviderVDC                = URN(VcloudPrefix + "providervdc:")
+	VDCTemplate                = URN(VcloudPrefix + "vdctemplate:")
+	Role                       = URN(VcloudPrefix + "role:")
+	Right                      = URN(VcloudPrefix + "right:")
+	Task                       = URN(VcloudPrefix + "task:")
+
+	// * VCD Runtime Defined Entities.
+	// Entity is urn:vcloud:entity:<vendor>:<nss>:<uuid>.
+	Entity = URN(VcloudPrefix + "entity:")
+	// EntityType is urn:vcloud:type:<vendor>:<nss>:<version>.
+	EntityType = URN(VcloudPrefix + "type:")
+
+	// * VCF Tenant Manager.
+	Region     = URN(VcloudPrefix + "region:")
+	Supervisor = URN(VcloudPrefix + "supervisor:")
+	VCenter    = URN(VcloudPrefix + "vimserver:")
+
+	// * CLOUDAVENUE.
+	VCDA = URN(CloudAvenuePrefix + "vcda:")
+)
+
+// builtinTypes is the list of URN types provided by the package.
+// They are registered in the default registry at package initialization.
+var builtinTypes = []TypeInfo{
+	{
+		Name:              "org",
+		Type:              Org,
+		DisplayName:       "Organization",
+		MediaType:         "application/vnd.vmware.vcloud.org+xml",
+		TerraformResource: "cloudavenue_org",
+		Routes:            Routes{CloudAPI: "1.0.0/orgs", Legacy: "org"},
+	},
+	{
+		Name:              "vm",
+		Type:              VM,
+		DisplayName:       "Virtual Machine",
+		MediaType:         "application/vnd.vmware.vcloud.vm+xml",
+		TerraformResource: "cloudavenue_vm",
+		Routes:            Routes{Legacy: "vApp", LegacyIDPrefix: "vm-"},
+		Parents:           []URN{VAPP},
+	},
+	{
+		Name:              "user",
+		Type:              User,
+		DisplayName:       "User",
+		MediaType:         "application/vnd.vmware.admin.user+xml",
+		TerraformResource: "cloudavenue_iam_user",
+		Routes:            Routes{CloudAPI: "1.0.0/users", Legacy: "admin/user"},
+		Parents:           []URN{Org},
+	},
+	{
+		Name:        "group",
+		Type:        Group,
+		DisplayName: "Group",
+		MediaType:   "application/vnd.vmware.admin.group+xml",
+		Routes:      Routes{CloudAPI: "1.0.0/groups", Legacy: "admin/group"},
+		Parents:     []URN{Org},
+	},
+	{
+		Name:              "edgegateway",
+		Type:              EdgeGateway,
+		DisplayName:       "Edge Gateway",
+		MediaType:         "application/vnd.vmware.admin.edgeGateway+xml",
+		TerraformResource: "cloudavenue_edgegateway",
+		Routes:            Routes{CloudAPI: "1.0.0/edgeGateways", Legacy: "admin/edgeGateway"},
+		Parents:           []URN{VDC, VDCGroup},
+	},
+	{
+		Name:              "vdc",
+		Type:              VDC,
+		DisplayName:       "VDC",
+		MediaType:         "application/vnd.vmware.vcloud.vdc+xml",
+		TerraformResource: "cloudavenue_vdc",
+		Routes:            Routes{CloudAPI: "1.0.0/vdcs", Legacy: "vdc"},
+		Parents:           []URN{Org},
+	},
+	{
+		Name:              "vdcGroup",
+		Type:              VDCGroup,
+		DisplayName:       "VDC Group",
+		TerraformResource: "cloudavenue_vdcg",
+		Routes:            Routes{CloudAPI: "1.0.0/vdcGroups"},
+		Parents:           []URN{Org},
+	},
+	{
+		Name:        "vdcComputePolicy",
+		Type:        VDCComputePolicy,
+		DisplayName: "VDC Compute Policy",
+		Routes:      Routes{CloudAPI: "2.0.0/vdcComputePolicies"},
+	},
+	{
+		Name:        "network",
+		Type:        Network,
+		DisplayName: "Network",
+		Aliases:     []string{"orgVdcNetwork", "externalNetwork", "vappNetwork"},
+		MediaType:   "application/vnd.vmware.vcloud.orgNetwork+xml",
+		Routes:      Routes{CloudAPI: "1.0.0/orgVdcNetworks", Legacy: "network"},
+		Parents:     []URN{VDC, VDCGroup},
+	},
+	{
+		Name:        "vdcstorageProfile",
+		Type:        VDCStorageProfile,
+		DisplayName: "VDC Storage Profile",
+		MediaType:   "application/vnd.vmware.vcloud.vdcStorageProfile+xml",
+		Routes:      Routes{Legacy: "vdcStorageProfile"},
+		Parents:     []URN{VDC},
+	},
+	{
+		Name:              "vapp",
+		Type:              VAPP,
+		DisplayName:       "vApp",
+		MediaType:         "application/vnd.vmware.vcloud.vApp+xml",
+		TerraformResource: "cloudavenue_vapp",
+		Routes:            Routes{Legacy: "vApp", LegacyIDPrefix: "vapp-"},
+		Parents:           []URN{VDC},
+	},
+	{
+		Name:        "vappTemplate",
+		Type:        VAPPTemplate,
+		DisplayName: "vApp Template",
+		MediaType:   "application/vnd.vmware.vcloud.vAppTemplate+xml",
+		Routes:      Routes{Legacy: "vAppTemplate", LegacyIDPrefix: "vappTemplate-"},
+		Parents:     []URN{Catalog},
+	},
+	{
+		Name:              "disk",
+		Type:              Disk,
+		DisplayName:       "Disk",
+		MediaType:         "application/vnd.vmware.vcloud.disk+xml",
+		TerraformResource: "cloudavenue_vm_disk",
+		Routes:            Routes{Legacy: "disk"},
+		Parents:           []URN{VDC},
+	},
+	{
+		Name:              "firewallGroup",
+		Type:              SecurityGroup,
+		DisplayName:       "Security Group",
+		Aliases:           []string{"SecurityGroup", "ipSet"},
+		TerraformResource: "cloudavenue_edgegateway_security_group",
+		Routes:            Routes{CloudAPI: "1.0.0/firewallGroups"},
+		Parents:           []URN{EdgeGateway},
+	},
+	{
+		Name:              "catalog",
+		Type:              Catalog,
+		DisplayName:       "Catalog",
+		MediaType:         "application/vnd.vmware.vcloud.catalog+xml",
+		TerraformResource: "cloudavenue_catalog",
+		Routes:            Routes{Legacy: "catalog"},
+		Parents:           []URN{Org},
+	},
+	{
+		Name:              "token",
+		Type:              Token,
+		DisplayName:       "Token",
+		TerraformResource: "cloudavenue_iam_token",
+		Routes:            Routes{CloudAPI: "1.0.0/tokens"},
+		Parents:           []URN{User},
+	},
+	{
+		Name:              "applicationPortProfile",
+		Type:              AppPortProfile,
+		DisplayName:       "Application Port Profile",
+		Aliases:           []string{"AppPortProfile"},
+		TerraformResource: "cloudavenue_edgegateway_app_port_profile",
+		Routes:            Routes{CloudAPI: "1.0.0/applicationPortProfiles"},
+		Parents:           []URN{Org},
+	},
+	{
+		Name:              "certificateLibraryItem",
+		Type:              CertificateLibraryItem,
+		DisplayName:       "Certificate Library Item",
+		TerraformResource: "cloudavenue_org_certificate_library",
+		Routes:            Routes{CloudAPI: "1.0.0/ssl/certificateLibrary"},
+		Parents:           []URN{Org},
+	},
+	{
+		Name:              "loadBalancerPool",
+		Type:              LoadBalancerPool,
+		DisplayName:       "Load Balancer Pool",
+		TerraformResource: "cloudavenue_elb_pool",
+		Routes:            Routes{CloudAPI: "1.0.0/loadBalancer/pools"},
+		Parents:           []URN{EdgeGateway},
+	},
+	{
+		Name:              "loadBalancerVirtualService",
+		Type:              LoadBalancerVirtualService,
+		DisplayName:       "Load Balancer Virtual Service",
+		TerraformResource: "cloudavenue_elb_virtual_service",
+		Routes:            Routes{CloudAPI: "1.0.0/loadBalancer/virtualServices"},
+		Parents:           []URN{EdgeGateway},
+	},
+	{
+		Name:        "serviceEngineGroup",
+		Type:        ServiceEngineGroup,
+		DisplayName: "Service Engine Group",
+		Routes:      Routes{CloudAPI: "1.0.0/loadBalancer/serviceEngineGroups"},
+	},
+	{
+		Name:        "site",
+		Type:        Site,
+		DisplayName: "Site",
+	},
+	{
+		Name:        "providerVdc",
+		Type:        ProviderVDC,
+		DisplayName: "Provider VDC",
+		MediaType:   "application/vnd.vmware.admin.providervdc+xml",
+		Routes:      Routes{CloudAPI: "1.0.0/providerVdcs", Legacy: "admin/extension/providervdc"},
+	},
+	{
+		Name:        "vdcTemplate",
+		Type:        VDCTemplate,
+		DisplayName: "VDC Template",
+		MediaType:   "application/vnd.vmware.admin.vdcTemplate+xml",
+		Routes:      Routes{Legacy: "vdcTemplate"},
+	},
+	{
+		Name:              "role",
+		Type:              Role,
+		DisplayName:       "Role",
+		MediaType:         "application/vnd.vmware.admin.role+xml",
+		TerraformResource: "cloudavenue_iam_role",
+		Routes:            Routes{CloudAPI: "1.0.0/roles", Legacy: "admin/role"},
+		Parents:           []URN{Org},
+	},
+	{
+		Name:        "right",
+		Type:        Right,
+		DisplayName: "Right",
+		MediaType:   "application/vnd.vmware.admin.right+xml",
+		Routes:      Routes{CloudAPI: "1.0.0/rights", Legacy: "admin/right"},
+	},
+	{
+		Name:        "task",
+		Type:        Task,
+		DisplayName: "Task",
+		MediaType:   "application/vnd.vmware.vcloud.task+xml",
+		Routes:      Routes{Legacy: "task"},
+		Parents:     []URN{Org},
+	},
+	{
+		Name:        "entity",
+		Type:        Entity,
+		DisplayName: "Runtime Defined Entity",
+		Aliases:     []string{"rde"},
+		Routes:      Routes{CloudAPI: "1.0.0/entities"},
+		Parents:     []URN{EntityType},
+		format:      formatEntity,
+	},
+	{
+		Name:        "entityType",
+		Type:        EntityType,
+		DisplayName: "Runtime Defined Entity Type",
+		Aliases:     []string{"rdeType"},
+		Routes:      Routes{CloudAPI: "1.0.0/entityTypes"},
+		format:      formatEntityType,
+	},
+	{
+		Name:        "region",
+		Type:        Region,
+		DisplayName: "Region",
+		Routes:      Routes{CloudAPI: "1.0.0/regions"},
+	},
+	{
+		Name:        "supervisor",
+		Type:        Supervisor,
+		DisplayName: "Supervisor",
+		Routes:      Routes{CloudAPI: "1.0.0/supervisors"},
+		Parents:     []URN{Region},
+	},
+	{
+		Name:        "vcenter",
+		Type:        VCenter,
+		DisplayName: "vCenter Server",
+		Aliases:     []string{"vimServer"},
+		Routes:      Routes{CloudAPI: "1.0.0/virtualCenters", Legacy: "admin/extension/vimServer"},
+	},
+	{
+		Name:        "vcda",
+		Type:        VCDA,
+		DisplayName: "VCDA",
+	},
+}
+
+// URNs is the list of URN types provided by the package.
+//
+// Deprecated: URNs does not contain the types added with Register. Use All instead.
+var URNs = []URN{
+	Org,
+	VM,
+	User,
+	Group,
+	EdgeGateway,
+	VDC,
+	VDCGroup,
+	VDCComputePolicy,
+	Network,
+	VDCStorageProfile,
+	VAPP,
+	VAPPTemplate,
+	Disk,
+	SecurityGroup,
+	Catalog,
+	Token,
+	AppPortProfile,
+	CertificateLibraryItem,
+	LoadBalancerPool,
+	LoadBalancerVirtualService,
+	ServiceEngineGroup,
+	Site,
+	ProviderVDC,
+	VDCTemplate,
+	Role,
+	Right,
+	Task,
+	Entity,
+	EntityType,
+	Region,
+	Supervisor,
+	VCenter,
+	VCDA,
+}
+
+// URNByNames is the map of URN types provided by the package indexed by name.
+//
+// Deprecated: URNByNames does not contain the types added with Register. Use Lookup instead.
+var URNByNames = map[string]URN{
+	"org":                        Org,
+	"vm":                         VM,
+	"user":                       User,
+	"group":                      Group,
+	"edgegateway":                EdgeGateway,
+	"vdc":                        VDC,
+	"vdcGroup":                   VDCGroup,
+	"vdcComputePolicy":           VDCComputePolicy,
+	"network":                    Network,
+	"vdcstorageProfile":          VDCStorageProfile,
+	"vapp":                       VAPP,
+	"vappTemplate":               VAPPTemplate,
+	"disk":                       Disk,
+	"firewallGroup":              SecurityGroup,
+	"catalog":                    Catalog,
+	"token":                      Token,
+	"applicationPortProfile":     AppPortProfile,
+	"certificateLibraryItem":     CertificateLibraryItem,
+	"loadBalancerPool":           LoadBalancerPool,
+	"loadBalancerVirtualService": LoadBalancerVirtualService,
+	"serviceEngineGroup":         ServiceEngineGroup,
+	"site":                       Site,
+	"providerVdc":                ProviderVDC,
+	"vdcTemplate":                VDCTemplate,
+	"role":                       Role,
+	"right":                      Right,
+	"task":                       Task,
+	"entity":                     Entity,
+	"entityType":                 EntityType,
+	"region":                     Region,
+	"supervisor":                 Supervisor,
+	"vcenter":                    VCenter,
+	"vcda":                       VCDA,
+}
@@ -0,0 +1,86 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"testing"
+)
+
+// sampleOf returns a valid URN of the builtin type.
+func sampleOf(t TypeInfo) string {
+	switch t.format {
+	case formatEntity:
+		return t.Type.String() + "vmware:tkgcluster:" + validUUIDv4
+	case formatEntityType:
+		return t.Type.String() + "vmware:tkgcluster:1.0.0"
+	default:
+		return t.Type.String() + validUUIDv4
+	}
+}
+
+// TestCatalogue cross-checks every builtin type across URNs, URNByNames,
+// the registry and the IsXxx functions and methods.
+func TestCatalogue(t *testing.T) {
+	if len(URNs) != len(builtinTypes) || len(URNByNames) != len(builtinTypes) {
+		t.Fatalf("len(URNs) = %d, len(URNByNames) = %d, want %d", len(URNs), len(URNByNames), len(builtinTypes))
+	}
+
+	for i, bt := range builtinTypes {
+		t.Run(bt.Name, func(t *testing.T) {
+			if URNs[i] != bt.Type {
+				t.Errorf("URNs[%d] = %v, want %v", i, URNs[i], bt.Type)
+			}
+			if got := URNByNames[bt.Name]; got != bt.Type {
+				t.Errorf("URNByNames[%q] = %v, want %v", bt.Name, got, bt.Type)
+			}
+			if got, err := FindURNTypeFromString(bt.Name); err != nil || got != bt.Type {
+				t.Errorf("FindURNTypeFromString(%q) = %v, %v, want %v", bt.Name, got, err, bt.Type)
+			}
+			for _, alias := range bt.Aliases {
+				if got, err := FindURNTypeFromString(alias); err != nil || got != bt.Type {
+					t.Errorf("FindURNTypeFromString(%q) = %v, %v, want %v", alias, got, err, bt.Type)
+				}
+			}
+
+			sample := sampleOf(bt)
+			if !IsValid(sample) {
+				t.Errorf("IsValid(%q) = false, want true", sample)
+			}
+			if info, ok := Info(URN(sample)); !ok || info.Type != bt.Type {
+				t.Errorf("Info(%q) = %v, %v, want %v", sample, info.Type, ok, bt.Type)
+			}
+
+			// Exactly one IsXxx helper matches the URN.
+			for typ, isFunc := range isFuncsByType {
+				if got := isFunc(sample); got != (typ == bt.Type) {
+					t.Errorf("Is function of %v(%q) = %v, want %v", typ, sample, got, typ == bt.Type)
+				}
+			}
+			for typ, isMethod := range isMethodsByType {
+				if got := isMethod(URN(sample)); got != (typ == bt.Type) {
+					t.Errorf("Is method of %v(%q) = %v, want %v", typ, sample, got, typ == bt.Type)
+				}
+			}
+		})
+	}
+
+	if len(isFuncsByType) != len(builtinTypes) || len(isMethodsByType) != len(builtinTypes) {
+		t.Errorf("len(isFuncsByType) = %d, len(isMethodsByType) = %d, want %d", len(isFuncsByType), len(isMethodsByType), len(builtinTypes))
+	}
+}
@@ -0,0 +1,160 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/orange-cloudavenue/common-go/regex"
+)
+
+// ErrUnexpectedType is returned when the URN is valid but not of the expected type.
+var ErrUnexpectedType = errors.New("unexpected URN type")
+
+var (
+	_ encoding.TextMarshaler   = URN("")
+	_ encoding.TextUnmarshaler = (*URN)(nil)
+	_ json.Marshaler           = URN("")
+	_ json.Unmarshaler         = (*URN)(nil)
+	_ sql.Scanner              = (*URN)(nil)
+	_ driver.Valuer            = URN("")
+)
+
+type decodeOptions struct {
+	expected      URN
+	allowBareUUID bool
+}
+
+// DecodeOption is an option of Decode.
+type DecodeOption func(*decodeOptions)
+
+// WithExpectedType rejects the URNs that are not of the expected type.
+func WithExpectedType(expected URN) DecodeOption {
+	return func(o *decodeOptions) {
+		o.expected = expected
+	}
+}
+
+// WithBareUUID accepts bare UUIDs and normalizes them (see NormalizeStrict) as URNs
+// of the expected type. It implies WithExpectedType.
+func WithBareUUID(expected URN) DecodeOption {
+	return func(o *decodeOptions) {
+		o.expected = expected
+		o.allowBareUUID = true
+	}
+}
+
+// Decode validates the value and returns it as a URN.
+// An empty value returns an empty URN without error.
+// By default the value must be a valid URN of a registered type (see Parse).
+// Use WithExpectedType to restrict the type and WithBareUUID to accept legacy
+// values containing only the UUID.
+func Decode(value string, opts ...DecodeOption) (URN, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	o := decodeOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if id, ok := regex.ParseUUID(value, regex.UUIDDefault); o.allowBareUUID && ok {
+		return NormalizeStrict(o.expected, id)
+	}
+
+	p, err := Parse(value)
+	if err != nil {
+		return "", err
+	}
+
+	if !o.expected.isEmpty() && p.Type != o.expected {
+		return "", &ParseError{Input: value, Err: fmt.Errorf("%w: got %s, expected %s", ErrUnexpectedType, p.Type, o.expected)}
+	}
+
+	return p.URN(), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (urn URN) MarshalText() ([]byte, error) {
+	return []byte(urn), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// The text must be empty or a valid URN of a registered type.
+func (urn *URN) UnmarshalText(text []byte) error {
+	u, err := Decode(string(text))
+	if err != nil {
+		return err
+	}
+
+	*urn = u
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (urn URN) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(urn))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// The value must be null, an empty string or a valid URN of a registered type.
+func (urn *URN) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	return urn.UnmarshalText([]byte(s))
+}
+
+// Scan implements sql.Scanner.
+// NULL is scanned as an empty URN.
+func (urn *URN) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*urn = ""
+		return nil
+	case string:
+		return urn.UnmarshalText([]byte(v))
+	case []byte:
+		return urn.UnmarshalText(v)
+	default:
+		return fmt.Errorf("cannot scan %T into urn.URN", src)
+	}
+}
+
+// Value implements driver.Valuer.
+// An empty URN is stored as NULL.
+func (urn URN) Value() (driver.Value, error) {
+	if urn.isEmpty() {
+		return nil, nil
+	}
+
+	return urn.String(), nil
+}
@@ -0,0 +1,169 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		opts    []DecodeOption
+		want    URN
+		wantErr error
+	}{
+		{
+			name:  "ValidURN",
+			value: VDC.String() + validUUIDv4,
+			want:  URN(VDC.String() + validUUIDv4),
+		},
+		{
+			name:  "EmptyString",
+			value: "",
+			want:  "",
+		},
+		{
+			name:    "InvalidURN",
+			value:   VDC.String() + "invalid",
+			wantErr: ErrInvalidUUID,
+		},
+		{
+			name:    "BareUUIDNotAllowed",
+			value:   validUUIDv4,
+			wantErr: ErrMissingPrefix,
+		},
+		{
+			name:  "BareUUIDAllowed",
+			value: validUUIDv4,
+			opts:  []DecodeOption{WithBareUUID(VDC)},
+			want:  URN(VDC.String() + validUUIDv4),
+		},
+		{
+			name:    "BareUUIDUnknownType",
+			value:   validUUIDv4,
+			opts:    []DecodeOption{WithBareUUID("urn:vcloud:unknown:")},
+			wantErr: ErrUnknownType,
+		},
+		{
+			name:  "ExpectedType",
+			value: VDC.String() + validUUIDv4,
+			opts:  []DecodeOption{WithExpectedType(VDC)},
+			want:  URN(VDC.String() + validUUIDv4),
+		},
+		{
+			name:    "UnexpectedType",
+			value:   VM.String() + validUUIDv4,
+			opts:    []DecodeOption{WithBareUUID(VDC)},
+			wantErr: ErrUnexpectedType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decode(tt.value, tt.opts...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Decode() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Decode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURN_JSON(t *testing.T) {
+	type payload struct {
+		ID URN `json:"id"`
+	}
+
+	u := URN(VDC.String() + validUUIDv4)
+
+	data, err := json.Marshal(payload{ID: u})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if want := `{"id":"` + u.String() + `"}`; string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var got payload
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if got.ID != u {
+		t.Errorf("json.Unmarshal() = %v, want %v", got.ID, u)
+	}
+
+	if err := json.Unmarshal([]byte(`{"id":null}`), &got); err != nil || got.ID != u {
+		t.Errorf("json.Unmarshal(null) = %v, %v, want the value unchanged", got.ID, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"id":"urn:vcloud:vdc:invalid"}`), &got); !errors.Is(err, ErrInvalidUUID) {
+		t.Errorf("json.Unmarshal() error = %v, want %v", err, ErrInvalidUUID)
+	}
+
+	// The map keys use encoding.TextMarshaler.
+	data, err = json.Marshal(map[URN]int{u: 1})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var m map[URN]int
+	if err := json.Unmarshal(data, &m); err != nil || m[u] != 1 {
+		t.Errorf("json.Unmarshal() = %v, %v", m, err)
+	}
+}
+
+func TestURN_SQL(t *testing.T) {
+	u := URN(VM.String() + validUUIDv4)
+
+	tests := []struct {
+		name    string
+		src     any
+		want    URN
+		wantErr bool
+	}{
+		{name: "String", src: u.String(), want: u},
+		{name: "Bytes", src: []byte(u.String()), want: u},
+		{name: "Null", src: nil, want: ""},
+		{name: "Invalid", src: "urn:vcloud:vm:invalid", wantErr: true},
+		{name: "UnsupportedType", src: 42, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got URN
+			err := got.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("URN.Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("URN.Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if v, err := u.Value(); err != nil || v != u.String() {
+		t.Errorf("URN.Value() = %v, %v, want %v", v, err, u)
+	}
+	if v, err := URN("").Value(); err != nil || v != nil {
+		t.Errorf("URN.Value() = %v, %v, want nil", v, err)
+	}
+}
@@ -18,43 +18,39 @@
 
 package urn
 
-import "regexp"
+import (
+	"fmt"
 
-var extractUUIDv4Regex = regexp.MustCompile(`([a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12})`)
+	"github.com/orange-cloudavenue/common-go/regex"
+)
 
-// ExtractUUID finds an UUID in the input string
+// ExtractUUID finds an UUID in the input string (see regex.UUIDDefault)
+// and returns it in canonical form (lower case).
 // Returns an empty string if no UUID was found.
 func ExtractUUID(input string) string {
-	matchListIDs := extractUUIDv4Regex.FindAllStringSubmatch(input, -1)
-	// matchListIDs contains all matches of the regular expression in the input string.
-	// Each match is a slice where the first element is the full match and subsequent elements are capture groups.
-	if len(matchListIDs) > 0 && len(matchListIDs[0]) > 1 {
-		// Return the first match's first capture group (UUID).
-		return matchListIDs[0][1]
+	uuids := regex.FindUUIDs(input, regex.UUIDDefault)
+	if len(uuids) > 0 {
+		// Return the first UUID.
+		return uuids[0]
 	}
 	return ""
 }
 
 // IsValid returns true if the URN is valid.
-// Checks if the URN is of the specified type and if it is a valid UUIDv4.
+// Checks if the URN is of a registered type and if it contains a valid UUID
+// (or a valid composite identifier for the Runtime Defined Entities).
 func IsValid(urn string) bool {
 	if len(urn) == 0 {
 		return false
 	}
 
-	u := URN(urn)
-
-	for _, prefix := range URNs {
-		// Check if the URN is of the specified type.
-		if u.IsType(prefix) {
-			// Check if the URN is a valid UUIDv4.
-			return isUUIDV4(extractUUIDv4(urn, prefix))
-		}
-	}
-	return false
+	_, err := Parse(urn)
+	return err == nil
 }
 
 // Normalize returns the URN with the prefix if prefix is missing.
+// The prefix is not checked: use NormalizeStrict to reject the prefixes that are
+// not registered or that cannot be built from a UUID.
 func Normalize(prefix URN, uuid string) URN {
 	u := URN(uuid)
 	if u.ContainsPrefix() {
@@ -67,3 +63,22 @@ func Normalize(prefix URN, uuid string) URN {
 
 	return prefix + u
 }
+
+// NormalizeStrict is like Normalize but returns a *ParseError wrapping ErrUnknownType
+// if the prefix is not a registered URN type, and ErrMissingPrefix if it is the type
+// of a composite URN (Entity, EntityType) that cannot be built from a UUID.
+func NormalizeStrict(prefix URN, uuid string) (URN, error) {
+	if u := URN(uuid); u.ContainsPrefix() {
+		return u, nil
+	}
+
+	t, ok := defaultRegistry.lookupURN(prefix.String())
+	if !ok {
+		return "", &ParseError{Input: uuid, Err: fmt.Errorf("%w %q", ErrUnknownType, prefix)}
+	}
+	if t.format != formatUUID {
+		return "", &ParseError{Input: uuid, Err: fmt.Errorf("%w: a %s URN cannot be built from a UUID", ErrMissingPrefix, t.Name)}
+	}
+
+	return prefix + URN(uuid), nil
+}
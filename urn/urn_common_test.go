@@ -18,7 +18,10 @@
 
 package urn
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 func TestNormalize(t *testing.T) {
 	type args struct {
@@ -47,6 +50,15 @@ func TestNormalize(t *testing.T) {
 			},
 			want: "",
 		},
+		// Check the prefix is not checked
+		{
+			name: "CustomPrefix",
+			args: args{
+				prefix: "urn:custom:thing:",
+				uuid:   validUUIDv4,
+			},
+			want: URN("urn:custom:thing:" + validUUIDv4),
+		},
 		// Check uuid is already an URN
 		{
 			name: "AlreadyURN",
@@ -66,6 +78,33 @@ func TestNormalize(t *testing.T) {
 	}
 }
 
+func TestNormalizeStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		prefix  URN
+		uuid    string
+		want    URN
+		wantErr error
+	}{
+		{name: "Normalize", prefix: VM, uuid: validUUIDv4, want: URN(VM.String() + validUUIDv4)},
+		{name: "AlreadyURN", prefix: VM, uuid: VM.String() + validUUIDv4, want: URN(VM.String() + validUUIDv4)},
+		{name: "EmptyPrefix", prefix: "", uuid: validUUIDv4, wantErr: ErrUnknownType},
+		{name: "CustomPrefix", prefix: "urn:custom:thing:", uuid: validUUIDv4, wantErr: ErrUnknownType},
+		{name: "CompositePrefix", prefix: Entity, uuid: validUUIDv4, wantErr: ErrMissingPrefix},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeStrict(tt.prefix, tt.uuid)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NormalizeStrict() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeStrict() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestExtractUUID(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -0,0 +1,159 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/orange-cloudavenue/common-go/regex"
+)
+
+// ErrInvalidSegment is returned when a segment of a composite URN (vendor, NSS or version) is malformed.
+var ErrInvalidSegment = errors.New("malformed URN segment")
+
+const (
+	// EntitySegmentRegexString matches the vendor and the NSS segments of the composite URNs (see NewEntity).
+	EntitySegmentRegexString = `[A-Za-z0-9][A-Za-z0-9_.-]*`
+	// EntityVersionRegexString matches the version of the EntityType URNs (e.g. 1.0.0).
+	EntityVersionRegexString = `[0-9]+\.[0-9]+\.[0-9]+`
+)
+
+var (
+	entitySegmentRegex = regexp.MustCompile("^" + EntitySegmentRegexString + "$")
+	entityVersionRegex = regexp.MustCompile("^" + EntityVersionRegexString + "$")
+)
+
+// idFormat is the format of the part of a URN following the prefix.
+type idFormat int
+
+const (
+	// formatUUID is the default format: <uuid>.
+	formatUUID idFormat = iota
+	// formatEntity is the format of the Runtime Defined Entities: <vendor>:<nss>:<uuid>.
+	formatEntity
+	// formatEntityType is the format of the Runtime Defined Entity types: <vendor>:<nss>:<version>.
+	formatEntityType
+)
+
+// segments are the components of the part of a URN following the prefix.
+type segments struct {
+	Vendor  string
+	NSS     string
+	Version string
+	ID      string
+}
+
+// parse parses the part of a URN following the prefix.
+func (f idFormat) parse(value string) (segments, error) {
+	if f == formatUUID {
+		id, ok := regex.ParseUUID(value, regex.UUIDDefault)
+		if !ok {
+			return segments{}, fmt.Errorf("%w %q", ErrInvalidUUID, value)
+		}
+		return segments{ID: id}, nil
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return segments{}, fmt.Errorf("%w %q (expected <vendor>:<nss>:<%s>)", ErrInvalidSegment, value, f.lastSegment())
+	}
+
+	s := segments{Vendor: parts[0], NSS: parts[1]}
+	if !entitySegmentRegex.MatchString(s.Vendor) {
+		return segments{}, fmt.Errorf("%w: vendor %q", ErrInvalidSegment, s.Vendor)
+	}
+	if !entitySegmentRegex.MatchString(s.NSS) {
+		return segments{}, fmt.Errorf("%w: nss %q", ErrInvalidSegment, s.NSS)
+	}
+
+	switch f {
+	case formatEntity:
+		id, ok := regex.ParseUUID(parts[2], regex.UUIDDefault)
+		if !ok {
+			return segments{}, fmt.Errorf("%w %q", ErrInvalidUUID, parts[2])
+		}
+		s.ID = id
+	case formatEntityType:
+		if !entityVersionRegex.MatchString(parts[2]) {
+			return segments{}, fmt.Errorf("%w: version %q", ErrInvalidSegment, parts[2])
+		}
+		s.Version = parts[2]
+	}
+
+	return s, nil
+}
+
+func (f idFormat) lastSegment() string {
+	if f == formatEntityType {
+		return "version"
+	}
+	return "uuid"
+}
+
+// String returns the part of a URN following the prefix.
+func (s segments) String() string {
+	if s.Vendor == "" {
+		return s.ID
+	}
+
+	last := s.ID
+	if s.Version != "" {
+		last = s.Version
+	}
+
+	return s.Vendor + ":" + s.NSS + ":" + last
+}
+
+// formatOf returns the format of the URN type.
+// The format of an unregistered type is formatUUID.
+func formatOf(prefix URN) idFormat {
+	t, ok := defaultRegistry.lookupURN(prefix.String())
+	if !ok || t.Type != prefix {
+		return formatUUID
+	}
+
+	return t.format
+}
+
+// NewEntity returns the URN of a Runtime Defined Entity
+// (urn:vcloud:entity:<vendor>:<nss>:<uuid>).
+// Returns a *ParseError if a component is malformed.
+func NewEntity(vendor, nss, id string) (URN, error) {
+	return newComposite(Entity, segments{Vendor: vendor, NSS: nss, ID: id})
+}
+
+// NewEntityType returns the URN of a Runtime Defined Entity type
+// (urn:vcloud:type:<vendor>:<nss>:<version>).
+// Returns a *ParseError if a component is malformed.
+func NewEntityType(vendor, nss, version string) (URN, error) {
+	return newComposite(EntityType, segments{Vendor: vendor, NSS: nss, Version: version})
+}
+
+// newComposite returns the composite URN in canonical form (lower-case UUID).
+func newComposite(prefix URN, s segments) (URN, error) {
+	p, err := Parse((prefix + URN(s.String())).String())
+	if err != nil {
+		return "", err
+	}
+
+	return p.URN(), nil
+}
@@ -0,0 +1,139 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParse_Entity(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    Parsed
+		wantErr error
+	}{
+		{
+			name:  "Entity",
+			value: "urn:vcloud:entity:vmware:tkgcluster:" + validUUIDv4,
+			want: Parsed{
+				Namespace: "vcloud",
+				TypeName:  "entity",
+				Type:      Entity,
+				ID:        validUUIDv4,
+				Vendor:    "vmware",
+				NSS:       "tkgcluster",
+			},
+		},
+		{
+			name:  "EntityType",
+			value: "urn:vcloud:type:vmware:tkgcluster:1.0.0",
+			want: Parsed{
+				Namespace: "vcloud",
+				TypeName:  "type",
+				Type:      EntityType,
+				Vendor:    "vmware",
+				NSS:       "tkgcluster",
+				Version:   "1.0.0",
+			},
+		},
+		{
+			name:    "EntityMissingSegment",
+			value:   "urn:vcloud:entity:vmware:" + validUUIDv4,
+			wantErr: ErrInvalidSegment,
+		},
+		{
+			name:    "EntityInvalidUUID",
+			value:   "urn:vcloud:entity:vmware:tkgcluster:invalid",
+			wantErr: ErrInvalidUUID,
+		},
+		{
+			name:    "EntityInvalidVendor",
+			value:   "urn:vcloud:entity:-vmware:tkgcluster:" + validUUIDv4,
+			wantErr: ErrInvalidSegment,
+		},
+		{
+			name:    "EntityTypeInvalidVersion",
+			value:   "urn:vcloud:type:vmware:tkgcluster:v1",
+			wantErr: ErrInvalidSegment,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+			if got.URN().String() != tt.value {
+				t.Errorf("Parsed.URN() = %v, want %v", got.URN(), tt.value)
+			}
+			if !IsValid(tt.value) {
+				t.Errorf("IsValid(%s) = false, want true", tt.value)
+			}
+		})
+	}
+}
+
+func TestNewEntity(t *testing.T) {
+	got, err := NewEntity("vmware", "tkgcluster", validUUIDv4)
+	if err != nil || got.String() != "urn:vcloud:entity:vmware:tkgcluster:"+validUUIDv4 {
+		t.Errorf("NewEntity() = %v, %v", got, err)
+	}
+	if got.ID() != validUUIDv4 {
+		t.Errorf("URN.ID() = %v, want %v", got.ID(), validUUIDv4)
+	}
+
+	// The UUID is canonicalized.
+	if got, err := NewEntity("vmware", "tkgcluster", strings.ToUpper(validUUIDv4)); err != nil || got.String() != "urn:vcloud:entity:vmware:tkgcluster:"+validUUIDv4 {
+		t.Errorf("NewEntity() = %v, %v", got, err)
+	}
+
+	if _, err := NewEntity("vmware", "tkg:cluster", validUUIDv4); !errors.Is(err, ErrInvalidSegment) {
+		t.Errorf("NewEntity() error = %v, want %v", err, ErrInvalidSegment)
+	}
+}
+
+func TestNewEntityType(t *testing.T) {
+	got, err := NewEntityType("vmware", "tkgcluster", "1.0.0")
+	if err != nil || got.String() != "urn:vcloud:type:vmware:tkgcluster:1.0.0" {
+		t.Errorf("NewEntityType() = %v, %v", got, err)
+	}
+
+	if _, err := NewEntityType("vmware", "tkgcluster", "latest"); !errors.Is(err, ErrInvalidSegment) {
+		t.Errorf("NewEntityType() error = %v, want %v", err, ErrInvalidSegment)
+	}
+}
+
+func TestNormalize_Entity(t *testing.T) {
+	if _, err := NormalizeStrict(Entity, validUUIDv4); !errors.Is(err, ErrMissingPrefix) {
+		t.Errorf("NormalizeStrict(Entity) error = %v, want %v", err, ErrMissingPrefix)
+	}
+
+	if _, err := Decode(validUUIDv4, WithBareUUID(Entity)); !errors.Is(err, ErrMissingPrefix) {
+		t.Errorf("Decode() error = %v, want %v", err, ErrMissingPrefix)
+	}
+}
@@ -21,17 +21,47 @@ package urn
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
+// UnknownTypeError is returned by FindURNTypeFromString when no URN type matches the value.
+// It matches ErrUnknownType with errors.Is.
+type UnknownTypeError struct {
+	// Value is the value that does not match any URN type.
+	Value string
+	// Suggestions are the names of the URN types close to the value.
+	Suggestions []string
+}
+
+// Error implements the error interface.
+func (e *UnknownTypeError) Error() string {
+	msg := fmt.Sprintf("URN type %s does not exist in package urn", e.Value)
+	if len(e.Suggestions) > 0 {
+		msg += fmt.Sprintf(" (did you mean %s?)", strings.Join(e.Suggestions, " or "))
+	}
+
+	return msg
+}
+
+// Is returns true if the target is ErrUnknownType.
+func (e *UnknownTypeError) Is(target error) bool {
+	return target == ErrUnknownType
+}
+
 // FindURNTypeFromString returns the URN type from a string.
+// The value is matched against the name, the aliases, the type segment of the
+// prefix (e.g. "gateway") and the Go constant name (e.g. "EdgeGateway") of the
+// registered URN types. The match ignores the case and the separators, so
+// "vdcGroup", "VDCGroup", "vdc_group" and "vdc-group" are equivalent.
+// If no URN type matches, an *UnknownTypeError listing the close matches is returned.
 func FindURNTypeFromString(value string) (URN, error) {
 	if value == "" {
 		return "", errors.New("value does not contain an URN type provided")
 	}
 
-	if u, ok := URNByNames[value]; ok {
-		return u, nil
+	if t, ok := Lookup(value); ok {
+		return t.Type, nil
 	}
 
-	return "", fmt.Errorf("URN type %s does not exist in package urn", value)
+	return "", &UnknownTypeError{Value: value, Suggestions: defaultRegistry.suggest(value)}
 }
@@ -24,9 +24,9 @@ import (
 )
 
 func TestFindURNTypeFromString(t *testing.T) {
-	// Setup a fake URNByNames for testing
-	URNByNames = map[string]URN{
-		"test-type": "test-type",
+	// Register a fake URN type for testing
+	if err := Register("test-type", "urn:test:testType:"); err != nil && !errors.Is(err, ErrAlreadyRegistered) {
+		t.Fatalf("Register() error = %v", err)
 	}
 
 	tests := []struct {
@@ -46,7 +46,7 @@ func TestFindURNTypeFromString(t *testing.T) {
 		{
 			name:    "existing type",
 			input:   "test-type",
-			want:    "test-type",
+			want:    "urn:test:testType:",
 			wantErr: false,
 		},
 		{
@@ -56,6 +56,48 @@ func TestFindURNTypeFromString(t *testing.T) {
 			wantErr:   true,
 			errString: "URN type not-exist does not exist in package urn",
 		},
+		{
+			name:  "canonical name",
+			input: "edgegateway",
+			want:  EdgeGateway,
+		},
+		{
+			name:  "prefix segment",
+			input: "gateway",
+			want:  EdgeGateway,
+		},
+		{
+			name:  "go constant name",
+			input: "SecurityGroup",
+			want:  SecurityGroup,
+		},
+		{
+			name:  "go constant name with acronym",
+			input: "VDCStorageProfile",
+			want:  VDCStorageProfile,
+		},
+		{
+			name:  "snake case",
+			input: "edge_gateway",
+			want:  EdgeGateway,
+		},
+		{
+			name:  "kebab case",
+			input: "vdc-group",
+			want:  VDCGroup,
+		},
+		{
+			name:  "camel case",
+			input: "vdcStorageProfile",
+			want:  VDCStorageProfile,
+		},
+		{
+			name:      "close match",
+			input:     "vdcGrop",
+			want:      "",
+			wantErr:   true,
+			errString: "URN type vdcGrop does not exist in package urn (did you mean vdcGroup?)",
+		},
 	}
 
 	for _, tt := range tests {
@@ -68,6 +110,9 @@ func TestFindURNTypeFromString(t *testing.T) {
 			if got != tt.want {
 				t.Errorf("FindURNTypeFromString() = %v, want %v", got, tt.want)
 			}
+			if tt.wantErr && err != nil && tt.input != "" && !errors.Is(err, ErrUnknownType) {
+				t.Errorf("FindURNTypeFromString() error = %v, want ErrUnknownType", err)
+			}
 			if tt.wantErr && err != nil && tt.errString != "" {
 				if !errors.Is(err, errors.New(tt.errString)) && err.Error() != tt.errString {
 					t.Errorf("FindURNTypeFromString() error = %v, want %v", err, tt.errString)
@@ -0,0 +1,199 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrCycle is returned by Register when the parents of the URN type create a cycle in the hierarchy.
+var ErrCycle = errors.New("cycle in the URN type hierarchy")
+
+// WithParents sets the URN types that can contain a resource of this type.
+func WithParents(parents ...URN) RegisterOption {
+	return func(t *TypeInfo) {
+		t.Parents = append(t.Parents, parents...)
+	}
+}
+
+// ParentsOf returns the URN types that can contain a resource of the type
+// (e.g. ParentsOf(VM) returns [VAPP]).
+// Returns nil if the type is not registered or has no parent.
+func ParentsOf(t URN) []URN {
+	info, ok := defaultRegistry.lookupURN(t.String())
+	if !ok || info.Type != t || len(info.Parents) == 0 {
+		return nil
+	}
+
+	return info.Parents
+}
+
+// ChildrenOf returns the URN types whose resources can be contained in a resource of the type
+// (e.g. ChildrenOf(VAPP) returns [VM]).
+// The children are returned in registration order.
+func ChildrenOf(t URN) []URN {
+	var children []URN
+	for _, info := range All() {
+		if slices.Contains(info.Parents, t) {
+			children = append(children, info.Type)
+		}
+	}
+
+	return children
+}
+
+// TopologicalOrder returns the URN types ordered so that a type always comes
+// after its parents (e.g. Org, VDC, VAPP, VM). It is the creation order;
+// reverse it to get the deletion order.
+// If no type is provided, all the registered types are returned.
+// The types with the same depth in the hierarchy keep the registration order.
+// The unregistered types are returned last, in the provided order.
+func TopologicalOrder(types ...URN) []URN {
+	depths, order := defaultRegistry.ordering()
+
+	if len(types) == 0 {
+		types = defaultRegistry.urns()
+	} else {
+		types = slices.Clone(types)
+	}
+
+	slices.SortStableFunc(types, func(a, b URN) int {
+		return compareRank(depths, order, a, b)
+	})
+
+	return types
+}
+
+// SortForCreation sorts the URNs so that a resource always comes after the
+// resources of its parent types (e.g. a VDC before its vApps, a vApp before its VMs).
+// Returns a *ParseError if a URN is not valid; the slice is then left unchanged.
+func SortForCreation(urns []URN) error {
+	return sortByHierarchy(urns, false)
+}
+
+// SortForDeletion sorts the URNs so that a resource always comes before the
+// resources of its parent types (e.g. the VMs before their vApp).
+// Returns a *ParseError if a URN is not valid; the slice is then left unchanged.
+func SortForDeletion(urns []URN) error {
+	return sortByHierarchy(urns, true)
+}
+
+func sortByHierarchy(urns []URN, reverse bool) error {
+	types := make(map[URN]URN, len(urns))
+	for _, u := range urns {
+		p, err := Parse(u.String())
+		if err != nil {
+			return err
+		}
+		types[u] = p.Type
+	}
+
+	depths, order := defaultRegistry.ordering()
+	slices.SortStableFunc(urns, func(a, b URN) int {
+		c := compareRank(depths, order, types[a], types[b])
+		if reverse {
+			return -c
+		}
+		return c
+	})
+
+	return nil
+}
+
+// compareRank compares the URN types by depth in the hierarchy then by registration order.
+func compareRank(depths map[URN]int, order map[URN]int, a, b URN) int {
+	da, okA := depths[a]
+	db, okB := depths[b]
+	switch {
+	case !okA && !okB:
+		return 0
+	case !okA:
+		return 1
+	case !okB:
+		return -1
+	case da != db:
+		return da - db
+	default:
+		return order[a] - order[b]
+	}
+}
+
+// ordering returns the depth and the registration index of each registered URN type.
+func (r *registry) ordering() (depths map[URN]int, order map[URN]int) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// The cycles are rejected by register.
+	depths, _ = r.depths()
+
+	order = make(map[URN]int, len(r.types))
+	for i, t := range r.types {
+		order[t.Type] = i
+	}
+
+	return depths, order
+}
+
+// depths returns the depth of each registered URN type in the hierarchy:
+// 0 for a type without parent, 1 + the maximum depth of its parents otherwise.
+// The parents that are not registered are ignored.
+// The caller must hold the lock.
+func (r *registry) depths() (map[URN]int, error) {
+	depths := make(map[URN]int, len(r.types))
+	visiting := make(map[URN]bool)
+
+	var visit func(t URN) (int, error)
+	visit = func(t URN) (int, error) {
+		if d, ok := depths[t]; ok {
+			return d, nil
+		}
+		if visiting[t] {
+			return 0, fmt.Errorf("%w: %s", ErrCycle, t)
+		}
+
+		visiting[t] = true
+		defer delete(visiting, t)
+
+		d := 0
+		for _, parent := range r.types[r.byType[t]].Parents {
+			if _, ok := r.byType[parent]; !ok {
+				continue
+			}
+
+			pd, err := visit(parent)
+			if err != nil {
+				return 0, err
+			}
+			d = max(d, pd+1)
+		}
+
+		depths[t] = d
+		return d, nil
+	}
+
+	for _, t := range r.types {
+		if _, err := visit(t.Type); err != nil {
+			return nil, err
+		}
+	}
+
+	return depths, nil
+}
@@ -0,0 +1,125 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestParentsOf(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  URN
+		want []URN
+	}{
+		{name: "VM", typ: VM, want: []URN{VAPP}},
+		{name: "EdgeGateway", typ: EdgeGateway, want: []URN{VDC, VDCGroup}},
+		{name: "Org", typ: Org, want: nil},
+		{name: "Unknown", typ: "urn:vcloud:unknown:", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParentsOf(tt.typ); !slices.Equal(got, tt.want) {
+				t.Errorf("ParentsOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChildrenOf(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  URN
+		want []URN
+	}{
+		{name: "VAPP", typ: VAPP, want: []URN{VM}},
+		{name: "EdgeGateway", typ: EdgeGateway, want: []URN{SecurityGroup, LoadBalancerPool, LoadBalancerVirtualService}},
+		{name: "VM", typ: VM, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ChildrenOf(tt.typ); !slices.Equal(got, tt.want) {
+				t.Errorf("ChildrenOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTopologicalOrder(t *testing.T) {
+	got := TopologicalOrder(VM, LoadBalancerPool, VAPP, "urn:vcloud:unknown:", Org, EdgeGateway, VDC)
+	want := []URN{Org, VDC, EdgeGateway, VAPP, VM, LoadBalancerPool, "urn:vcloud:unknown:"}
+	if !slices.Equal(got, want) {
+		t.Errorf("TopologicalOrder() = %v, want %v", got, want)
+	}
+
+	// All the registered types: every type comes after its parents.
+	all := TopologicalOrder()
+	for i, typ := range all {
+		for _, parent := range ParentsOf(typ) {
+			if idx := slices.Index(all, parent); idx > i {
+				t.Errorf("TopologicalOrder(): %s comes before its parent %s", typ, parent)
+			}
+		}
+	}
+}
+
+func TestSortForCreationAndDeletion(t *testing.T) {
+	vm := URN(VM.String() + validUUIDv4)
+	vapp := URN(VAPP.String() + validUUIDv4)
+	vdc := URN(VDC.String() + validUUIDv4)
+
+	urns := []URN{vm, vdc, vapp}
+	if err := SortForCreation(urns); err != nil {
+		t.Fatalf("SortForCreation() error = %v", err)
+	}
+	if want := []URN{vdc, vapp, vm}; !slices.Equal(urns, want) {
+		t.Errorf("SortForCreation() = %v, want %v", urns, want)
+	}
+
+	if err := SortForDeletion(urns); err != nil {
+		t.Fatalf("SortForDeletion() error = %v", err)
+	}
+	if want := []URN{vm, vapp, vdc}; !slices.Equal(urns, want) {
+		t.Errorf("SortForDeletion() = %v, want %v", urns, want)
+	}
+
+	invalid := []URN{vm, "invalid"}
+	if err := SortForCreation(invalid); !errors.Is(err, ErrMissingPrefix) {
+		t.Errorf("SortForCreation() error = %v, want %v", err, ErrMissingPrefix)
+	}
+}
+
+func TestRegistry_Cycle(t *testing.T) {
+	r := newRegistry(nil)
+
+	if err := r.register(TypeInfo{Name: "a", Type: "urn:test:a:", Parents: []URN{"urn:test:b:"}}); err != nil {
+		t.Fatalf("register(a) error = %v", err)
+	}
+	if err := r.register(TypeInfo{Name: "b", Type: "urn:test:b:", Parents: []URN{"urn:test:a:"}}); !errors.Is(err, ErrCycle) {
+		t.Fatalf("register(b) error = %v, want %v", err, ErrCycle)
+	}
+	if _, ok := r.lookup("b"); ok {
+		t.Error("the type creating a cycle is registered")
+	}
+	if err := r.register(TypeInfo{Name: "b", Type: "urn:test:b:"}); err != nil {
+		t.Errorf("register(b) error = %v", err)
+	}
+}
@@ -0,0 +1,170 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/orange-cloudavenue/common-go/regex"
+)
+
+var (
+	// ErrNoRoute is returned when the URN type has no route for the requested API.
+	ErrNoRoute = errors.New("no route for the URN type")
+	// ErrInvalidHref is returned when the href does not reference a known resource.
+	ErrInvalidHref = errors.New("href does not reference a known resource")
+)
+
+// Routes describes where a URN type is exposed in the VCD API.
+type Routes struct {
+	// CloudAPI is the CloudAPI collection path including the API version (e.g. "1.0.0/vdcs").
+	// The href of a resource is <endpoint>/cloudapi/<CloudAPI>/<urn>.
+	CloudAPI string
+	// Legacy is the legacy XML API path (e.g. "vdc" or "admin/edgeGateway").
+	// The href of a resource is <endpoint>/api/<Legacy>/<LegacyIDPrefix><uuid>.
+	Legacy string
+	// LegacyIDPrefix is the prefix of the UUID in the legacy XML API (e.g. "vm-" or "vapp-").
+	LegacyIDPrefix string
+}
+
+// WithRoutes sets the routes of the URN type in the VCD API.
+func WithRoutes(routes Routes) RegisterOption {
+	return func(t *TypeInfo) {
+		t.Routes = routes
+	}
+}
+
+// FromHref returns the URN of the resource referenced by a VCD API href.
+// Both CloudAPI hrefs (https://vcd/cloudapi/1.0.0/vdcs/urn:vcloud:vdc:<uuid>)
+// and legacy XML API hrefs (https://vcd/api/vApp/vm-<uuid>, https://vcd/api/vdc/<uuid>)
+// are supported. Trailing path segments (e.g. /power/action/powerOn) are ignored.
+// Returns an error wrapping ErrInvalidHref if the href does not reference a known resource.
+func FromHref(href string) (URN, error) {
+	if href == "" {
+		return "", &ParseError{Input: href, Err: ErrEmpty}
+	}
+
+	u, err := url.Parse(href)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidHref, err)
+	}
+
+	path := u.EscapedPath()
+
+	if _, rest, ok := strings.Cut(path, "/cloudapi/"); ok {
+		for _, segment := range strings.Split(rest, "/") {
+			segment, err := url.PathUnescape(segment)
+			if err != nil || !strings.HasPrefix(segment, "urn:") {
+				continue
+			}
+
+			return Decode(segment)
+		}
+
+		return "", fmt.Errorf("%w %q: no URN in the CloudAPI path", ErrInvalidHref, href)
+	}
+
+	if _, rest, ok := strings.Cut(path, "/api/"); ok {
+		rest = strings.TrimPrefix(rest, "admin/")
+		for _, t := range All() {
+			if t.Routes.Legacy == "" {
+				continue
+			}
+
+			p, ok := cutPrefixFold(rest, strings.TrimPrefix(t.Routes.Legacy, "admin/")+"/")
+			if !ok {
+				continue
+			}
+
+			id, ok := cutPrefixFold(p, t.Routes.LegacyIDPrefix)
+			if !ok {
+				continue
+			}
+
+			id, _, _ = strings.Cut(id, "/")
+			id, ok = regex.ParseUUID(id, regex.UUIDDefault)
+			if !ok {
+				continue
+			}
+
+			return t.Type + URN(id), nil
+		}
+	}
+
+	return "", fmt.Errorf("%w %q", ErrInvalidHref, href)
+}
+
+// CloudAPIHref returns the CloudAPI href of the resource
+// (e.g. https://vcd.example.com/cloudapi/1.0.0/vdcs/urn:vcloud:vdc:<uuid>)
+// with the URN in canonical form.
+// The endpoint is the base URL of the VCD API (e.g. https://vcd.example.com).
+// Returns an error wrapping ErrNoRoute if the URN type is not exposed in the CloudAPI.
+func (urn URN) CloudAPIHref(endpoint string) (string, error) {
+	t, canonical, err := urn.routes()
+	if err != nil {
+		return "", err
+	}
+
+	if t.Routes.CloudAPI == "" {
+		return "", fmt.Errorf("%w: %s is not exposed in the CloudAPI", ErrNoRoute, t.Name)
+	}
+
+	return strings.TrimSuffix(endpoint, "/") + "/cloudapi/" + t.Routes.CloudAPI + "/" + canonical.String(), nil
+}
+
+// LegacyHref returns the legacy XML API href of the resource
+// (e.g. https://vcd.example.com/api/vApp/vm-<uuid>).
+// The endpoint is the base URL of the VCD API (e.g. https://vcd.example.com).
+// Returns an error wrapping ErrNoRoute if the URN type is not exposed in the legacy XML API.
+func (urn URN) LegacyHref(endpoint string) (string, error) {
+	t, canonical, err := urn.routes()
+	if err != nil {
+		return "", err
+	}
+
+	if t.Routes.Legacy == "" {
+		return "", fmt.Errorf("%w: %s is not exposed in the legacy XML API", ErrNoRoute, t.Name)
+	}
+
+	return strings.TrimSuffix(endpoint, "/") + "/api/" + t.Routes.Legacy + "/" + t.Routes.LegacyIDPrefix + canonical.ID(), nil
+}
+
+// routes returns the registered URN type of a valid URN and the URN in canonical
+// form (lower-case UUID), so that both hrefs reference the resource the same way.
+func (urn URN) routes() (TypeInfo, URN, error) {
+	p, err := Parse(urn.String())
+	if err != nil {
+		return TypeInfo{}, "", err
+	}
+
+	t, _ := defaultRegistry.lookupURN(p.Type.String())
+	return t, p.URN(), nil
+}
+
+// cutPrefixFold is like strings.CutPrefix but ignores the case.
+func cutPrefixFold(s, prefix string) (string, bool) {
+	if len(s) < len(prefix) || !strings.EqualFold(s[:len(prefix)], prefix) {
+		return s, false
+	}
+
+	return s[len(prefix):], true
+}
@@ -0,0 +1,193 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const testEndpoint = "https://vcd.example.com"
+
+func TestFromHref(t *testing.T) {
+	tests := []struct {
+		name    string
+		href    string
+		want    URN
+		wantErr error
+	}{
+		{
+			name: "CloudAPI",
+			href: testEndpoint + "/cloudapi/1.0.0/vdcs/" + VDC.String() + validUUIDv4,
+			want: URN(VDC.String() + validUUIDv4),
+		},
+		{
+			name: "CloudAPIEscaped",
+			href: testEndpoint + "/cloudapi/1.0.0/edgeGateways/urn%3Avcloud%3Agateway%3A" + validUUIDv4,
+			want: URN(EdgeGateway.String() + validUUIDv4),
+		},
+		{
+			name: "CloudAPISubResource",
+			href: testEndpoint + "/cloudapi/1.0.0/edgeGateways/" + EdgeGateway.String() + validUUIDv4 + "/nat/rules",
+			want: URN(EdgeGateway.String() + validUUIDv4),
+		},
+		{
+			name: "LegacyVM",
+			href: testEndpoint + "/api/vApp/vm-" + validUUIDv4,
+			want: URN(VM.String() + validUUIDv4),
+		},
+		{
+			name: "LegacyVAPP",
+			href: testEndpoint + "/api/vApp/vapp-" + validUUIDv4,
+			want: URN(VAPP.String() + validUUIDv4),
+		},
+		{
+			name: "LegacyVAPPTemplate",
+			href: testEndpoint + "/api/vAppTemplate/vappTemplate-" + validUUIDv4,
+			want: URN(VAPPTemplate.String() + validUUIDv4),
+		},
+		{
+			name: "LegacyVDC",
+			href: testEndpoint + "/api/vdc/" + validUUIDv4,
+			want: URN(VDC.String() + validUUIDv4),
+		},
+		{
+			name: "LegacyAdminVDC",
+			href: testEndpoint + "/api/admin/vdc/" + validUUIDv4,
+			want: URN(VDC.String() + validUUIDv4),
+		},
+		{
+			name: "LegacyAction",
+			href: testEndpoint + "/api/vApp/vm-" + validUUIDv4 + "/power/action/powerOn",
+			want: URN(VM.String() + validUUIDv4),
+		},
+		{
+			name:    "CloudAPIWithoutURN",
+			href:    testEndpoint + "/cloudapi/1.0.0/vdcs",
+			wantErr: ErrInvalidHref,
+		},
+		{
+			name:    "LegacyUnknownPath",
+			href:    testEndpoint + "/api/unknown/" + validUUIDv4,
+			wantErr: ErrInvalidHref,
+		},
+		{
+			name:    "EmptyString",
+			href:    "",
+			wantErr: ErrEmpty,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromHref(tt.href)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FromHref() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("FromHref() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURN_Href(t *testing.T) {
+	tests := []struct {
+		name        string
+		urn         URN
+		endpoint    string
+		wantCloud   string
+		wantLegacy  string
+		wantErrCAPI error
+		wantErrXML  error
+	}{
+		{
+			name:       "VDC",
+			urn:        URN(VDC.String() + validUUIDv4),
+			endpoint:   testEndpoint + "/",
+			wantCloud:  testEndpoint + "/cloudapi/1.0.0/vdcs/" + VDC.String() + validUUIDv4,
+			wantLegacy: testEndpoint + "/api/vdc/" + validUUIDv4,
+		},
+		{
+			name:       "UpperCaseUUID",
+			urn:        URN(VDC.String() + strings.ToUpper(validUUIDv4)),
+			endpoint:   testEndpoint,
+			wantCloud:  testEndpoint + "/cloudapi/1.0.0/vdcs/" + VDC.String() + validUUIDv4,
+			wantLegacy: testEndpoint + "/api/vdc/" + validUUIDv4,
+		},
+		{
+			name:        "VM",
+			urn:         URN(VM.String() + validUUIDv4),
+			endpoint:    testEndpoint,
+			wantErrCAPI: ErrNoRoute,
+			wantLegacy:  testEndpoint + "/api/vApp/vm-" + validUUIDv4,
+		},
+		{
+			name:       "LoadBalancerPool",
+			urn:        URN(LoadBalancerPool.String() + validUUIDv4),
+			endpoint:   testEndpoint,
+			wantCloud:  testEndpoint + "/cloudapi/1.0.0/loadBalancer/pools/" + LoadBalancerPool.String() + validUUIDv4,
+			wantErrXML: ErrNoRoute,
+		},
+		{
+			name:        "InvalidURN",
+			urn:         URN(VDC.String() + "invalid"),
+			endpoint:    testEndpoint,
+			wantErrCAPI: ErrInvalidUUID,
+			wantErrXML:  ErrInvalidUUID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.urn.CloudAPIHref(tt.endpoint)
+			if !errors.Is(err, tt.wantErrCAPI) || got != tt.wantCloud {
+				t.Errorf("URN.CloudAPIHref() = %v, %v, want %v, %v", got, err, tt.wantCloud, tt.wantErrCAPI)
+			}
+
+			got, err = tt.urn.LegacyHref(tt.endpoint)
+			if !errors.Is(err, tt.wantErrXML) || got != tt.wantLegacy {
+				t.Errorf("URN.LegacyHref() = %v, %v, want %v, %v", got, err, tt.wantLegacy, tt.wantErrXML)
+			}
+		})
+	}
+}
+
+func TestHref_RoundTrip(t *testing.T) {
+	for _, bt := range builtinTypes {
+		if bt.format != formatUUID {
+			continue
+		}
+
+		u := bt.Type + URN(validUUIDv4)
+		for _, build := range []func(string) (string, error){u.CloudAPIHref, u.LegacyHref} {
+			href, err := build(testEndpoint)
+			if errors.Is(err, ErrNoRoute) {
+				continue
+			}
+			if err != nil {
+				t.Fatalf("%s: unexpected error %v", bt.Name, err)
+			}
+
+			got, err := FromHref(href)
+			if err != nil || got != u {
+				t.Errorf("FromHref(%s) = %v, %v, want %v", href, got, err, u)
+			}
+		}
+	}
+}
@@ -7,28 +7,31 @@
  * or see the "LICENSE" file for more details.
  */
 
-/*
- * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
- * SPDX-License-Identifier: Mozilla Public License 2.0
- *
- * This software is distributed under the MPL-2.0 license.
- * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
- * or see the "LICENSE" file for more details.
- */
+// Code generated by urngen from catalogue.json. DO NOT EDIT.
 
 package urn
 
-// IsAppPortProfile returns true if the URN is a AppPortProfile URN.
-func IsAppPortProfile(urn string) bool {
-	return URN(urn).IsType(AppPortProfile)
-}
-
 // IsOrg returns true if the URN is an Org URN.
 func IsOrg(urn string) bool {
 	return URN(urn).IsType(Org)
 }
 
-// IsEdgeGateway returns true if the URN is a EdgeGateway URN.
+// IsVM returns true if the URN is a VM URN.
+func IsVM(urn string) bool {
+	return URN(urn).IsType(VM)
+}
+
+// IsUser returns true if the URN is an User URN.
+func IsUser(urn string) bool {
+	return URN(urn).IsType(User)
+}
+
+// IsGroup returns true if the URN is a Group URN.
+func IsGroup(urn string) bool {
+	return URN(urn).IsType(Group)
+}
+
+// IsEdgeGateway returns true if the URN is an EdgeGateway URN.
 func IsEdgeGateway(urn string) bool {
 	return URN(urn).IsType(EdgeGateway)
 }
@@ -43,14 +46,32 @@ func IsVDCGroup(urn string) bool {
 	return URN(urn).IsType(VDCGroup)
 }
 
+// IsVDCComputePolicy returns true if the URN is a VDCComputePolicy URN.
+func IsVDCComputePolicy(urn string) bool {
+	return URN(urn).IsType(VDCComputePolicy)
+}
+
 // IsNetwork returns true if the URN is a Network URN.
 func IsNetwork(urn string) bool {
 	return URN(urn).IsType(Network)
 }
 
-// IsLoadBalancerPool returns true if the URN is a LoadBalancerPool URN.
-func IsLoadBalancerPool(urn string) bool {
-	return URN(urn).IsType(LoadBalancerPool)
+// IsOrgVDCNetwork returns true if the URN is a Network URN,
+// the URN type of the org VDC networks.
+func IsOrgVDCNetwork(urn string) bool {
+	return URN(urn).IsType(Network)
+}
+
+// IsExternalNetwork returns true if the URN is a Network URN,
+// the URN type of the external networks.
+func IsExternalNetwork(urn string) bool {
+	return URN(urn).IsType(Network)
+}
+
+// IsVAppNetwork returns true if the URN is a Network URN,
+// the URN type of the vApp networks.
+func IsVAppNetwork(urn string) bool {
+	return URN(urn).IsType(Network)
 }
 
 // IsVDCStorageProfile returns true if the URN is a VDCStorageProfile URN.
@@ -78,24 +99,10 @@ func IsSecurityGroup(urn string) bool {
 	return URN(urn).IsType(SecurityGroup)
 }
 
-// IsVCDA returns true if the URN is a VCDA URN.
-func IsVCDA(urn string) bool {
-	return URN(urn).IsType(VCDA)
-}
-
-// IsVM returns true if the URN is a VM URN.
-func IsVM(urn string) bool {
-	return URN(urn).IsType(VM)
-}
-
-// IsUser returns true if the URN is a User URN.
-func IsUser(urn string) bool {
-	return URN(urn).IsType(User)
-}
-
-// IsGroup returns true if the URN is a Group URN.
-func IsGroup(urn string) bool {
-	return URN(urn).IsType(Group)
+// IsIPSet returns true if the URN is a SecurityGroup URN,
+// the URN type of the IP sets.
+func IsIPSet(urn string) bool {
+	return URN(urn).IsType(SecurityGroup)
 }
 
 // IsCatalog returns true if the URN is a Catalog URN.
@@ -108,9 +115,9 @@ func IsToken(urn string) bool {
 	return URN(urn).IsType(Token)
 }
 
-// IsVDCComputePolicy returns true if the URN is a VDCComputePolicy URN.
-func IsVDCComputePolicy(urn string) bool {
-	return URN(urn).IsType(VDCComputePolicy)
+// IsAppPortProfile returns true if the URN is an AppPortProfile URN.
+func IsAppPortProfile(urn string) bool {
+	return URN(urn).IsType(AppPortProfile)
 }
 
 // IsCertificateLibraryItem returns true if the URN is a CertificateLibraryItem URN.
@@ -118,6 +125,11 @@ func IsCertificateLibraryItem(urn string) bool {
 	return URN(urn).IsType(CertificateLibraryItem)
 }
 
+// IsLoadBalancerPool returns true if the URN is a LoadBalancerPool URN.
+func IsLoadBalancerPool(urn string) bool {
+	return URN(urn).IsType(LoadBalancerPool)
+}
+
 // IsLoadBalancerVirtualService returns true if the URN is a LoadBalancerVirtualService URN.
 func IsLoadBalancerVirtualService(urn string) bool {
 	return URN(urn).IsType(LoadBalancerVirtualService)
@@ -132,3 +144,58 @@ func IsServiceEngineGroup(urn string) bool {
 func IsSite(urn string) bool {
 	return URN(urn).IsType(Site)
 }
+
+// IsProviderVDC returns true if the URN is a ProviderVDC URN.
+func IsProviderVDC(urn string) bool {
+	return URN(urn).IsType(ProviderVDC)
+}
+
+// IsVDCTemplate returns true if the URN is a VDCTemplate URN.
+func IsVDCTemplate(urn string) bool {
+	return URN(urn).IsType(VDCTemplate)
+}
+
+// IsRole returns true if the URN is a Role URN.
+func IsRole(urn string) bool {
+	return URN(urn).IsType(Role)
+}
+
+// IsRight returns true if the URN is a Right URN.
+func IsRight(urn string) bool {
+	return URN(urn).IsType(Right)
+}
+
+// IsTask returns true if the URN is a Task URN.
+func IsTask(urn string) bool {
+	return URN(urn).IsType(Task)
+}
+
+// IsEntity returns true if the URN is an Entity URN.
+func IsEntity(urn string) bool {
+	return URN(urn).IsType(Entity)
+}
+
+// IsEntityType returns true if the URN is an EntityType URN.
+func IsEntityType(urn string) bool {
+	return URN(urn).IsType(EntityType)
+}
+
+// IsRegion returns true if the URN is a Region URN.
+func IsRegion(urn string) bool {
+	return URN(urn).IsType(Region)
+}
+
+// IsSupervisor returns true if the URN is a Supervisor URN.
+func IsSupervisor(urn string) bool {
+	return URN(urn).IsType(Supervisor)
+}
+
+// IsVCenter returns true if the URN is a VCenter URN.
+func IsVCenter(urn string) bool {
+	return URN(urn).IsType(VCenter)
+}
+
+// IsVCDA returns true if the URN is a VCDA URN.
+func IsVCDA(urn string) bool {
+	return URN(urn).IsType(VCDA)
+}
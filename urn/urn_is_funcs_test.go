@@ -7,789 +7,1244 @@
  * or see the "LICENSE" file for more details.
  */
 
-/*
- * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
- * SPDX-License-Identifier: Mozilla Public License 2.0
- *
- * This software is distributed under the MPL-2.0 license.
- * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
- * or see the "LICENSE" file for more details.
- */
+// Code generated by urngen from catalogue.json. DO NOT EDIT.
 
 package urn
 
 import "testing"
 
+// isFuncsByType are the IsXxx functions indexed by URN type.
+var isFuncsByType = map[URN]func(string) bool{
+	Org:                        IsOrg,
+	VM:                         IsVM,
+	User:                       IsUser,
+	Group:                      IsGroup,
+	EdgeGateway:                IsEdgeGateway,
+	VDC:                        IsVDC,
+	VDCGroup:                   IsVDCGroup,
+	VDCComputePolicy:           IsVDCComputePolicy,
+	Network:                    IsNetwork,
+	VDCStorageProfile:          IsVDCStorageProfile,
+	VAPP:                       IsVAPP,
+	VAPPTemplate:               IsVAPPTemplate,
+	Disk:                       IsDisk,
+	SecurityGroup:              IsSecurityGroup,
+	Catalog:                    IsCatalog,
+	Token:                      IsToken,
+	AppPortProfile:             IsAppPortProfile,
+	CertificateLibraryItem:     IsCertificateLibraryItem,
+	LoadBalancerPool:           IsLoadBalancerPool,
+	LoadBalancerVirtualService: IsLoadBalancerVirtualService,
+	ServiceEngineGroup:         IsServiceEngineGroup,
+	Site:                       IsSite,
+	ProviderVDC:                IsProviderVDC,
+	VDCTemplate:                IsVDCTemplate,
+	Role:                       IsRole,
+	Right:                      IsRight,
+	Task:                       IsTask,
+	Entity:                     IsEntity,
+	EntityType:                 IsEntityType,
+	Region:                     IsRegion,
+	Supervisor:                 IsSupervisor,
+	VCenter:                    IsVCenter,
+	VCDA:                       IsVCDA,
+}
+
+func TestIsOrg(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  string
+		want bool
+	}{
+		{
+			name: "IsOrg",
+			urn:  Org.String() + validUUIDv4,
+			want: true,
+		},
+		{
+			name: "IsNotOrg",
+			urn:  VM.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Org.String() + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsOrg(tt.urn); got != tt.want {
+				t.Errorf("IsOrg() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsVM(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  string
+		want bool
+	}{
+		{
+			name: "IsVM",
+			urn:  VM.String() + validUUIDv4,
+			want: true,
+		},
+		{
+			name: "IsNotVM",
+			urn:  User.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  VM.String() + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsVM(tt.urn); got != tt.want {
+				t.Errorf("IsVM() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsUser(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  string
+		want bool
+	}{
+		{
+			name: "IsUser",
+			urn:  User.String() + validUUIDv4,
+			want: true,
+		},
+		{
+			name: "IsNotUser",
+			urn:  Group.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  User.String() + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsUser(tt.urn); got != tt.want {
+				t.Errorf("IsUser() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestIsGroup(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     URN
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
 		{
 			name: "IsGroup",
-			urn:  URN(Group.String() + validUUIDv4),
+			urn:  Group.String() + validUUIDv4,
 			want: true,
 		},
 		{
 			name: "IsNotGroup",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			urn:  EdgeGateway.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Group.String() + "invalid",
 			want: false,
 		},
 		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsGroup(tt.urn.String()); got != tt.want {
+			if got := IsGroup(tt.urn); got != tt.want {
 				t.Errorf("IsGroup() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// IsGateway.
 func TestIsEdgeGateway(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     URN
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
 		{
-			name: "IsGateway",
-			urn:  URN(EdgeGateway.String() + validUUIDv4),
+			name: "IsEdgeGateway",
+			urn:  EdgeGateway.String() + validUUIDv4,
 			want: true,
 		},
 		{
-			name: "IsNotGateway",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+			name: "IsNotEdgeGateway",
+			urn:  VDC.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  EdgeGateway.String() + "invalid",
 			want: false,
 		},
 		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsEdgeGateway(tt.urn.String()); got != tt.want {
+			if got := IsEdgeGateway(tt.urn); got != tt.want {
 				t.Errorf("IsEdgeGateway() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// IsVDC.
 func TestIsVDC(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     URN
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
 		{
 			name: "IsVDC",
-			urn:  URN(VDC.String() + validUUIDv4),
+			urn:  VDC.String() + validUUIDv4,
 			want: true,
 		},
 		{
 			name: "IsNotVDC",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+			urn:  VDCGroup.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  VDC.String() + "invalid",
 			want: false,
 		},
 		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsVDC(tt.urn.String()); got != tt.want {
+			if got := IsVDC(tt.urn); got != tt.want {
 				t.Errorf("IsVDC() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// IsVDCGroup.
 func TestIsVDCGroup(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     URN
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
 		{
 			name: "IsVDCGroup",
-			urn:  URN(VDCGroup.String() + validUUIDv4),
+			urn:  VDCGroup.String() + validUUIDv4,
 			want: true,
 		},
 		{
 			name: "IsNotVDCGroup",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+			urn:  VDCComputePolicy.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  VDCGroup.String() + "invalid",
 			want: false,
 		},
 		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsVDCGroup(tt.urn.String()); got != tt.want {
+			if got := IsVDCGroup(tt.urn); got != tt.want {
 				t.Errorf("IsVDCGroup() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// IsNetwork.
-func TestIsNetwork(t *testing.T) {
+func TestIsVDCComputePolicy(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     URN
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
 		{
-			name: "IsNetwork",
-			urn:  URN(Network.String() + validUUIDv4),
+			name: "IsVDCComputePolicy",
+			urn:  VDCComputePolicy.String() + validUUIDv4,
 			want: true,
 		},
 		{
-			name: "IsNotNetwork",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+			name: "IsNotVDCComputePolicy",
+			urn:  Network.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  VDCComputePolicy.String() + "invalid",
 			want: false,
 		},
 		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsNetwork(tt.urn.String()); got != tt.want {
-				t.Errorf("IsNetwork() = %v, want %v", got, tt.want)
+			if got := IsVDCComputePolicy(tt.urn); got != tt.want {
+				t.Errorf("IsVDCComputePolicy() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// IsLoadBalancerPool.
-func TestIsLoadBalancerPool(t *testing.T) {
+func TestIsNetwork(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     URN
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
-		{ // IsLoadBalancerPool
-			name: "IsLoadBalancerPool",
-			urn:  URN(LoadBalancerPool.String() + validUUIDv4),
+		{
+			name: "IsNetwork",
+			urn:  Network.String() + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotLoadBalancerPool
-			name: "IsNotLoadBalancerPool",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+		{
+			name: "IsNotNetwork",
+			urn:  VDCStorageProfile.String() + validUUIDv4,
 			want: false,
 		},
-		{ // EmptyString
+		{
+			name: "InvalidID",
+			urn:  Network.String() + "invalid",
+			want: false,
+		},
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsLoadBalancerPool(tt.urn.String()); got != tt.want {
-				t.Errorf("IsLoadBalancerPool() = %v, want %v", got, tt.want)
+			if got := IsNetwork(tt.urn); got != tt.want {
+				t.Errorf("IsNetwork() = %v, want %v", got, tt.want)
+			}
+			if got := IsOrgVDCNetwork(tt.urn); got != tt.want {
+				t.Errorf("IsOrgVDCNetwork() = %v, want %v", got, tt.want)
+			}
+			if got := IsExternalNetwork(tt.urn); got != tt.want {
+				t.Errorf("IsExternalNetwork() = %v, want %v", got, tt.want)
+			}
+			if got := IsVAppNetwork(tt.urn); got != tt.want {
+				t.Errorf("IsVAppNetwork() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// IsVDCStorageProfile.
 func TestIsVDCStorageProfile(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     URN
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
-		{ // IsVDCStorageProfile
+		{
 			name: "IsVDCStorageProfile",
-			urn:  URN(VDCStorageProfile.String() + validUUIDv4),
+			urn:  VDCStorageProfile.String() + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotVDCStorageProfile
+		{
 			name: "IsNotVDCStorageProfile",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+			urn:  VAPP.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  VDCStorageProfile.String() + "invalid",
 			want: false,
 		},
-		{ // EmptyString
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsVDCStorageProfile(tt.urn.String()); got != tt.want {
+			if got := IsVDCStorageProfile(tt.urn); got != tt.want {
 				t.Errorf("IsVDCStorageProfile() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// IsVAPP.
 func TestIsVAPP(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     string
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
-		{ // IsVAPP
+		{
 			name: "IsVAPP",
-			urn:  URN(VAPP.String() + validUUIDv4).String(),
+			urn:  VAPP.String() + validUUIDv4,
+			want: true,
+		},
+		{
+			name: "IsNotVAPP",
+			urn:  VAPPTemplate.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  VAPP.String() + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsVAPP(tt.urn); got != tt.want {
+				t.Errorf("IsVAPP() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsVAPPTemplate(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  string
+		want bool
+	}{
+		{
+			name: "IsVAPPTemplate",
+			urn:  VAPPTemplate.String() + validUUIDv4,
+			want: true,
+		},
+		{
+			name: "IsNotVAPPTemplate",
+			urn:  Disk.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  VAPPTemplate.String() + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsVAPPTemplate(tt.urn); got != tt.want {
+				t.Errorf("IsVAPPTemplate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsDisk(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  string
+		want bool
+	}{
+		{
+			name: "IsDisk",
+			urn:  Disk.String() + validUUIDv4,
+			want: true,
+		},
+		{
+			name: "IsNotDisk",
+			urn:  SecurityGroup.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Disk.String() + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsDisk(tt.urn); got != tt.want {
+				t.Errorf("IsDisk() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsSecurityGroup(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  string
+		want bool
+	}{
+		{
+			name: "IsSecurityGroup",
+			urn:  SecurityGroup.String() + validUUIDv4,
+			want: true,
+		},
+		{
+			name: "IsNotSecurityGroup",
+			urn:  Catalog.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  SecurityGroup.String() + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsSecurityGroup(tt.urn); got != tt.want {
+				t.Errorf("IsSecurityGroup() = %v, want %v", got, tt.want)
+			}
+			if got := IsIPSet(tt.urn); got != tt.want {
+				t.Errorf("IsIPSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsCatalog(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  string
+		want bool
+	}{
+		{
+			name: "IsCatalog",
+			urn:  Catalog.String() + validUUIDv4,
+			want: true,
+		},
+		{
+			name: "IsNotCatalog",
+			urn:  Token.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Catalog.String() + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsCatalog(tt.urn); got != tt.want {
+				t.Errorf("IsCatalog() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsToken(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  string
+		want bool
+	}{
+		{
+			name: "IsToken",
+			urn:  Token.String() + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotVAPP
-			name: "IsNotVAPP",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791").String(),
+		{
+			name: "IsNotToken",
+			urn:  AppPortProfile.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Token.String() + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsToken(tt.urn); got != tt.want {
+				t.Errorf("IsToken() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAppPortProfile(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  string
+		want bool
+	}{
+		{
+			name: "IsAppPortProfile",
+			urn:  AppPortProfile.String() + validUUIDv4,
+			want: true,
+		},
+		{
+			name: "IsNotAppPortProfile",
+			urn:  CertificateLibraryItem.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  AppPortProfile.String() + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsAppPortProfile(tt.urn); got != tt.want {
+				t.Errorf("IsAppPortProfile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsCertificateLibraryItem(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  string
+		want bool
+	}{
+		{
+			name: "IsCertificateLibraryItem",
+			urn:  CertificateLibraryItem.String() + validUUIDv4,
+			want: true,
+		},
+		{
+			name: "IsNotCertificateLibraryItem",
+			urn:  LoadBalancerPool.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  CertificateLibraryItem.String() + "invalid",
 			want: false,
 		},
-		{ // EmptyString
+		{
 			name: "EmptyString",
-			urn:  URN("").String(),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsVAPP(tt.urn); got != tt.want {
-				t.Errorf("IsVAPP() = %v, want %v", got, tt.want)
+			if got := IsCertificateLibraryItem(tt.urn); got != tt.want {
+				t.Errorf("IsCertificateLibraryItem() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// IsDisk.
-func TestIsDisk(t *testing.T) {
+func TestIsLoadBalancerPool(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     string
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
-		{ // IsDisk
-			name: "IsDisk",
-			urn:  URN(Disk.String() + validUUIDv4).String(),
+		{
+			name: "IsLoadBalancerPool",
+			urn:  LoadBalancerPool.String() + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotDisk
-			name: "IsNotDisk",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791").String(),
+		{
+			name: "IsNotLoadBalancerPool",
+			urn:  LoadBalancerVirtualService.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  LoadBalancerPool.String() + "invalid",
 			want: false,
 		},
-		{ // EmptyString
+		{
 			name: "EmptyString",
-			urn:  URN("").String(),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsDisk(tt.urn); got != tt.want {
-				t.Errorf("IsDisk() = %v, want %v", got, tt.want)
+			if got := IsLoadBalancerPool(tt.urn); got != tt.want {
+				t.Errorf("IsLoadBalancerPool() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// IsSecurityGroup.
-func TestIsSecurityGroup(t *testing.T) {
+func TestIsLoadBalancerVirtualService(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     string
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
-		{ // IsSecurityGroup
-			name: "IsSecurityGroup",
-			urn:  URN(SecurityGroup.String() + validUUIDv4).String(),
+		{
+			name: "IsLoadBalancerVirtualService",
+			urn:  LoadBalancerVirtualService.String() + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotSecurityGroup
-			name: "IsNotSecurityGroup",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791").String(),
+		{
+			name: "IsNotLoadBalancerVirtualService",
+			urn:  ServiceEngineGroup.String() + validUUIDv4,
 			want: false,
 		},
-		{ // EmptyString
+		{
+			name: "InvalidID",
+			urn:  LoadBalancerVirtualService.String() + "invalid",
+			want: false,
+		},
+		{
 			name: "EmptyString",
-			urn:  URN("").String(),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsSecurityGroup(tt.urn); got != tt.want {
-				t.Errorf("IsSecurityGroup() = %v, want %v", got, tt.want)
+			if got := IsLoadBalancerVirtualService(tt.urn); got != tt.want {
+				t.Errorf("IsLoadBalancerVirtualService() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// TestIsVCDA.
-func TestIsVCDA(t *testing.T) {
+func TestIsServiceEngineGroup(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     string
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
-		{ // IsVCDA
-			name: "IsVCDA",
-			urn:  URN(VCDA.String() + validUUIDv4).String(),
+		{
+			name: "IsServiceEngineGroup",
+			urn:  ServiceEngineGroup.String() + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotVCDA
-			name: "IsNotVCDA",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791").String(),
+		{
+			name: "IsNotServiceEngineGroup",
+			urn:  Site.String() + validUUIDv4,
 			want: false,
 		},
-		{ // EmptyString
+		{
+			name: "InvalidID",
+			urn:  ServiceEngineGroup.String() + "invalid",
+			want: false,
+		},
+		{
 			name: "EmptyString",
-			urn:  URN("").String(),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsVCDA(tt.urn); got != tt.want {
-				t.Errorf("IsVCDA() = %v, want %v", got, tt.want)
+			if got := IsServiceEngineGroup(tt.urn); got != tt.want {
+				t.Errorf("IsServiceEngineGroup() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// TestIsVM.
-func TestIsVM(t *testing.T) {
+func TestIsSite(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     string
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
-		{ // IsVM
-			name: "IsVM",
-			urn:  URN(VM.String() + validUUIDv4).String(),
+		{
+			name: "IsSite",
+			urn:  Site.String() + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotVM
-			name: "IsNotVM",
-			urn:  URN("urn:vcloud:user:f47ac10b-58cc-4372-a567-0e02b2c3d4791").String(),
+		{
+			name: "IsNotSite",
+			urn:  ProviderVDC.String() + validUUIDv4,
 			want: false,
 		},
-		{ // EmptyString
+		{
+			name: "InvalidID",
+			urn:  Site.String() + "invalid",
+			want: false,
+		},
+		{
 			name: "EmptyString",
-			urn:  URN("").String(),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsVM(tt.urn); got != tt.want {
-				t.Errorf("IsVM() = %v, want %v", got, tt.want)
+			if got := IsSite(tt.urn); got != tt.want {
+				t.Errorf("IsSite() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// TestIsUser.
-func TestIsUser(t *testing.T) {
+func TestIsProviderVDC(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     string
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
-		{ // IsUser
-			name: "IsUser",
-			urn:  URN(User.String() + validUUIDv4).String(),
+		{
+			name: "IsProviderVDC",
+			urn:  ProviderVDC.String() + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotUser
-			name: "IsNotUser",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791").String(),
+		{
+			name: "IsNotProviderVDC",
+			urn:  VDCTemplate.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  ProviderVDC.String() + "invalid",
 			want: false,
 		},
-		{ // EmptyString
+		{
 			name: "EmptyString",
-			urn:  URN("").String(),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsUser(tt.urn); got != tt.want {
-				t.Errorf("IsUser() = %v, want %v", got, tt.want)
+			if got := IsProviderVDC(tt.urn); got != tt.want {
+				t.Errorf("IsProviderVDC() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// TestIsOrg.
-func TestIsOrg(t *testing.T) {
+func TestIsVDCTemplate(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     string
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
-		{ // IsOrg
-			name: "IsOrg",
-			urn:  URN(Org.String() + validUUIDv4).String(),
+		{
+			name: "IsVDCTemplate",
+			urn:  VDCTemplate.String() + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotOrg
-			name: "IsNotOrg",
-			urn:  URN("urn:vcloud:user:f47ac10b-58cc-4372-a567-0e02b2c3d4791").String(),
+		{
+			name: "IsNotVDCTemplate",
+			urn:  Role.String() + validUUIDv4,
 			want: false,
 		},
-		{ // EmptyString
+		{
+			name: "InvalidID",
+			urn:  VDCTemplate.String() + "invalid",
+			want: false,
+		},
+		{
 			name: "EmptyString",
-			urn:  URN("").String(),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsOrg(tt.urn); got != tt.want {
-				t.Errorf("IsOrg() = %v, want %v", got, tt.want)
+			if got := IsVDCTemplate(tt.urn); got != tt.want {
+				t.Errorf("IsVDCTemplate() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// TestIsToken.
-func TestIsToken(t *testing.T) {
+func TestIsRole(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     string
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
-		{ // IsToken
-			name: "IsToken",
-			urn:  URN(Token.String() + validUUIDv4).String(),
+		{
+			name: "IsRole",
+			urn:  Role.String() + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotToken
-			name: "IsNotToken",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791").String(),
+		{
+			name: "IsNotRole",
+			urn:  Right.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Role.String() + "invalid",
 			want: false,
 		},
-		{ // EmptyString
+		{
 			name: "EmptyString",
-			urn:  URN("").String(),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsToken(tt.urn); got != tt.want {
-				t.Errorf("IsToken() = %v, want %v", got, tt.want)
+			if got := IsRole(tt.urn); got != tt.want {
+				t.Errorf("IsRole() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// TestIsAppPortProfile.
-func TestIsAppPortProfile(t *testing.T) {
+func TestIsRight(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     string
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
-		{ // IsAppPortProfile
-			name: "IsAppPortProfile",
-			urn:  URN(AppPortProfile.String() + validUUIDv4).String(),
+		{
+			name: "IsRight",
+			urn:  Right.String() + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotAppPortProfile
-			name: "IsNotAppPortProfile",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479").String(),
+		{
+			name: "IsNotRight",
+			urn:  Task.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Right.String() + "invalid",
 			want: false,
 		},
-		{ // EmptyString
+		{
 			name: "EmptyString",
-			urn:  URN("").String(),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsAppPortProfile(tt.urn); got != tt.want {
-				t.Errorf("IsAppPortProfile() = %v, want %v", got, tt.want)
+			if got := IsRight(tt.urn); got != tt.want {
+				t.Errorf("IsRight() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// TestIsVDCComputePolicy.
-func TestIsVDCComputePolicy(t *testing.T) {
+func TestIsTask(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     string
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
-		{ // IsVDCComputePolicy
-			name: "IsVDCComputePolicy",
-			urn:  URN(VDCComputePolicy.String() + validUUIDv4).String(),
+		{
+			name: "IsTask",
+			urn:  Task.String() + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotVDCComputePolicy
-			name: "IsNotVDCComputePolicy",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479").String(),
+		{
+			name: "IsNotTask",
+			urn:  Entity.String() + "vmware:tkgcluster:" + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Task.String() + "invalid",
 			want: false,
 		},
-		{ // EmptyString
+		{
 			name: "EmptyString",
-			urn:  URN("").String(),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsVDCComputePolicy(tt.urn); got != tt.want {
-				t.Errorf("IsAppPortProfile() = %v, want %v", got, tt.want)
+			if got := IsTask(tt.urn); got != tt.want {
+				t.Errorf("IsTask() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// TestIsCatalog.
-func TestIsCatalog(t *testing.T) {
+func TestIsEntity(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     string
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
-		{ // IsCatalog
-			name: "IsCatalog",
-			urn:  URN(Catalog.String() + validUUIDv4).String(),
+		{
+			name: "IsEntity",
+			urn:  Entity.String() + "vmware:tkgcluster:" + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotCatalog
-			name: "IsNotCatalog",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479").String(),
+		{
+			name: "IsNotEntity",
+			urn:  EntityType.String() + "vmware:tkgcluster:1.0.0",
 			want: false,
 		},
-		{ // EmptyString
+		{
+			name: "InvalidID",
+			urn:  Entity.String() + "invalid",
+			want: false,
+		},
+		{
 			name: "EmptyString",
-			urn:  URN("").String(),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsCatalog(tt.urn); got != tt.want {
-				t.Errorf("IsCatalog() = %v, want %v", got, tt.want)
+			if got := IsEntity(tt.urn); got != tt.want {
+				t.Errorf("IsEntity() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// TestIsVAPPTemplate.
-func TestIsVAPPTemplate(t *testing.T) {
+func TestIsEntityType(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     string
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
-		{ // IsVAPPTemplate
-			name: "IsVAPPTemplate",
-			urn:  URN(VAPPTemplate.String() + validUUIDv4).String(),
+		{
+			name: "IsEntityType",
+			urn:  EntityType.String() + "vmware:tkgcluster:1.0.0",
 			want: true,
 		},
-		{ // IsNotVAPPTemplate
-			name: "IsNotVAPPTemplate",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479").String(),
+		{
+			name: "IsNotEntityType",
+			urn:  Region.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  EntityType.String() + "invalid",
 			want: false,
 		},
-		{ // EmptyString
+		{
 			name: "EmptyString",
-			urn:  URN("").String(),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsVAPPTemplate(tt.urn); got != tt.want {
-				t.Errorf("IsVAPPTemplate() = %v, want %v", got, tt.want)
+			if got := IsEntityType(tt.urn); got != tt.want {
+				t.Errorf("IsEntityType() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// TestIsCertificateLibraryItem.
-func TestIsCertificateLibraryItem(t *testing.T) {
+func TestIsRegion(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     string
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
-		{ // IsCertificateLibraryItem
-			name: "IsCertificateLibraryItem",
-			urn:  URN(CertificateLibraryItem.String() + validUUIDv4).String(),
+		{
+			name: "IsRegion",
+			urn:  Region.String() + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotCertificateLibraryItem
-			name: "IsNotCertificateLibraryItem",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479").String(),
+		{
+			name: "IsNotRegion",
+			urn:  Supervisor.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Region.String() + "invalid",
 			want: false,
 		},
-		{ // EmptyString
+		{
 			name: "EmptyString",
-			urn:  URN("").String(),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsCertificateLibraryItem(tt.urn); got != tt.want {
-				t.Errorf("IsCertificateLibraryItem() = %v, want %v", got, tt.want)
+			if got := IsRegion(tt.urn); got != tt.want {
+				t.Errorf("IsRegion() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// TestIsLoadBalancerVirtualService.
-func TestIsLoadBalancerVirtualService(t *testing.T) {
+func TestIsSupervisor(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     string
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
-		{ // IsLoadBalancerVirtualService
-			name: "IsLoadBalancerVirtualService",
-			urn:  URN(LoadBalancerVirtualService.String() + validUUIDv4).String(),
+		{
+			name: "IsSupervisor",
+			urn:  Supervisor.String() + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotLoadBalancerVirtualService
-			name: "IsNotLoadBalancerVirtualService",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479").String(),
+		{
+			name: "IsNotSupervisor",
+			urn:  VCenter.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Supervisor.String() + "invalid",
 			want: false,
 		},
-		{ // EmptyString
+		{
 			name: "EmptyString",
-			urn:  URN("").String(),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsLoadBalancerVirtualService(tt.urn); got != tt.want {
-				t.Errorf("IsLoadBalancerVirtualService() = %v, want %v", got, tt.want)
+			if got := IsSupervisor(tt.urn); got != tt.want {
+				t.Errorf("IsSupervisor() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// TestIsServiceEngineGroup.
-func TestIsServiceEngineGroup(t *testing.T) {
+func TestIsVCenter(t *testing.T) {
 	tests := []struct {
-		name    string
-		urnType URN
-		urn     string
-		want    bool
+		name string
+		urn  string
+		want bool
 	}{
-		{ // IsServiceEngineGroup
-			name: "IsServiceEngineGroup",
-			urn:  URN(ServiceEngineGroup.String() + validUUIDv4).String(),
+		{
+			name: "IsVCenter",
+			urn:  VCenter.String() + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotServiceEngineGroup
-			name: "IsNotServiceEngineGroup",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479").String(),
+		{
+			name: "IsNotVCenter",
+			urn:  VCDA.String() + validUUIDv4,
 			want: false,
 		},
-		{ // EmptyString
+		{
+			name: "InvalidID",
+			urn:  VCenter.String() + "invalid",
+			want: false,
+		},
+		{
 			name: "EmptyString",
-			urn:  URN("").String(),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsServiceEngineGroup(tt.urn); got != tt.want {
-				t.Errorf("IsServiceEngineGroup() = %v, want %v", got, tt.want)
+			if got := IsVCenter(tt.urn); got != tt.want {
+				t.Errorf("IsVCenter() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestIsSite(t *testing.T) {
+func TestIsVCDA(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  string
 		want bool
 	}{
-		{ // IsSite
-			name: "IsSite",
-			urn:  URN(Site.String() + validUUIDv4).String(),
+		{
+			name: "IsVCDA",
+			urn:  VCDA.String() + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotSite
-			name: "IsNotSite",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791").String(),
+		{
+			name: "IsNotVCDA",
+			urn:  Org.String() + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  VCDA.String() + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN("").String(),
+			urn:  "",
 			want: false,
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsSite(tt.urn); got != tt.want {
-				t.Errorf("IsSite() = %v, want %v", got, tt.want)
+			if got := IsVCDA(tt.urn); got != tt.want {
+				t.Errorf("IsVCDA() = %v, want %v", got, tt.want)
 			}
 		})
 	}
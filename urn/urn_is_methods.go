@@ -7,22 +7,10 @@
  * or see the "LICENSE" file for more details.
  */
 
-/*
- * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
- * SPDX-License-Identifier: Mozilla Public License 2.0
- *
- * This software is distributed under the MPL-2.0 license.
- * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
- * or see the "LICENSE" file for more details.
- */
+// Code generated by urngen from catalogue.json. DO NOT EDIT.
 
 package urn
 
-// IsAppPortProfile returns true if the URN is a AppPortProfile URN.
-func (urn URN) IsAppPortProfile() bool {
-	return urn.IsType(AppPortProfile)
-}
-
 // IsOrg returns true if the URN is an Org URN.
 func (urn URN) IsOrg() bool {
 	return urn.IsType(Org)
@@ -33,7 +21,7 @@ func (urn URN) IsVM() bool {
 	return urn.IsType(VM)
 }
 
-// IsUser returns true if the URN is a User URN.
+// IsUser returns true if the URN is an User URN.
 func (urn URN) IsUser() bool {
 	return urn.IsType(User)
 }
@@ -58,14 +46,32 @@ func (urn URN) IsVDCGroup() bool {
 	return urn.IsType(VDCGroup)
 }
 
+// IsVDCComputePolicy returns true if the URN is a VDCComputePolicy URN.
+func (urn URN) IsVDCComputePolicy() bool {
+	return urn.IsType(VDCComputePolicy)
+}
+
 // IsNetwork returns true if the URN is a Network URN.
 func (urn URN) IsNetwork() bool {
 	return urn.IsType(Network)
 }
 
-// IsLoadBalancerPool returns true if the URN is a LoadBalancerPool URN.
-func (urn URN) IsLoadBalancerPool() bool {
-	return urn.IsType(LoadBalancerPool)
+// IsOrgVDCNetwork returns true if the URN is a Network URN,
+// the URN type of the org VDC networks.
+func (urn URN) IsOrgVDCNetwork() bool {
+	return urn.IsType(Network)
+}
+
+// IsExternalNetwork returns true if the URN is a Network URN,
+// the URN type of the external networks.
+func (urn URN) IsExternalNetwork() bool {
+	return urn.IsType(Network)
+}
+
+// IsVAppNetwork returns true if the URN is a Network URN,
+// the URN type of the vApp networks.
+func (urn URN) IsVAppNetwork() bool {
+	return urn.IsType(Network)
 }
 
 // IsVDCStorageProfile returns true if the URN is a VDCStorageProfile URN.
@@ -93,6 +99,12 @@ func (urn URN) IsSecurityGroup() bool {
 	return urn.IsType(SecurityGroup)
 }
 
+// IsIPSet returns true if the URN is a SecurityGroup URN,
+// the URN type of the IP sets.
+func (urn URN) IsIPSet() bool {
+	return urn.IsType(SecurityGroup)
+}
+
 // IsCatalog returns true if the URN is a Catalog URN.
 func (urn URN) IsCatalog() bool {
 	return urn.IsType(Catalog)
@@ -103,9 +115,9 @@ func (urn URN) IsToken() bool {
 	return urn.IsType(Token)
 }
 
-// IsVDCComputePolicy returns true if the URN is a VDCComputePolicy URN.
-func (urn URN) IsVDCComputePolicy() bool {
-	return urn.IsType(VDCComputePolicy)
+// IsAppPortProfile returns true if the URN is an AppPortProfile URN.
+func (urn URN) IsAppPortProfile() bool {
+	return urn.IsType(AppPortProfile)
 }
 
 // IsCertificateLibraryItem returns true if the URN is a CertificateLibraryItem URN.
@@ -113,6 +125,11 @@ func (urn URN) IsCertificateLibraryItem() bool {
 	return urn.IsType(CertificateLibraryItem)
 }
 
+// IsLoadBalancerPool returns true if the URN is a LoadBalancerPool URN.
+func (urn URN) IsLoadBalancerPool() bool {
+	return urn.IsType(LoadBalancerPool)
+}
+
 // IsLoadBalancerVirtualService returns true if the URN is a LoadBalancerVirtualService URN.
 func (urn URN) IsLoadBalancerVirtualService() bool {
 	return urn.IsType(LoadBalancerVirtualService)
@@ -123,12 +140,62 @@ func (urn URN) IsServiceEngineGroup() bool {
 	return urn.IsType(ServiceEngineGroup)
 }
 
-// IsVCDA returns true if the URN is a VCDA URN.
-func (urn URN) IsVCDA() bool {
-	return urn.IsType(VCDA)
-}
-
 // IsSite returns true if the URN is a Site URN.
 func (urn URN) IsSite() bool {
 	return urn.IsType(Site)
 }
+
+// IsProviderVDC returns true if the URN is a ProviderVDC URN.
+func (urn URN) IsProviderVDC() bool {
+	return urn.IsType(ProviderVDC)
+}
+
+// IsVDCTemplate returns true if the URN is a VDCTemplate URN.
+func (urn URN) IsVDCTemplate() bool {
+	return urn.IsType(VDCTemplate)
+}
+
+// IsRole returns true if the URN is a Role URN.
+func (urn URN) IsRole() bool {
+	return urn.IsType(Role)
+}
+
+// IsRight returns true if the URN is a Right URN.
+func (urn URN) IsRight() bool {
+	return urn.IsType(Right)
+}
+
+// IsTask returns true if the URN is a Task URN.
+func (urn URN) IsTask() bool {
+	return urn.IsType(Task)
+}
+
+// IsEntity returns true if the URN is an Entity URN.
+func (urn URN) IsEntity() bool {
+	return urn.IsType(Entity)
+}
+
+// IsEntityType returns true if the URN is an EntityType URN.
+func (urn URN) IsEntityType() bool {
+	return urn.IsType(EntityType)
+}
+
+// IsRegion returns true if the URN is a Region URN.
+func (urn URN) IsRegion() bool {
+	return urn.IsType(Region)
+}
+
+// IsSupervisor returns true if the URN is a Supervisor URN.
+func (urn URN) IsSupervisor() bool {
+	return urn.IsType(Supervisor)
+}
+
+// IsVCenter returns true if the URN is a VCenter URN.
+func (urn URN) IsVCenter() bool {
+	return urn.IsType(VCenter)
+}
+
+// IsVCDA returns true if the URN is a VCDA URN.
+func (urn URN) IsVCDA() bool {
+	return urn.IsType(VCDA)
+}
@@ -7,178 +7,229 @@
  * or see the "LICENSE" file for more details.
  */
 
-/*
- * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
- * SPDX-License-Identifier: Mozilla Public License 2.0
- *
- * This software is distributed under the MPL-2.0 license.
- * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
- * or see the "LICENSE" file for more details.
- */
+// Code generated by urngen from catalogue.json. DO NOT EDIT.
 
 package urn
 
 import "testing"
 
-func TestURN_IsVM(t *testing.T) {
+// isMethodsByType are the IsXxx methods indexed by URN type.
+var isMethodsByType = map[URN]func(URN) bool{
+	Org:                        URN.IsOrg,
+	VM:                         URN.IsVM,
+	User:                       URN.IsUser,
+	Group:                      URN.IsGroup,
+	EdgeGateway:                URN.IsEdgeGateway,
+	VDC:                        URN.IsVDC,
+	VDCGroup:                   URN.IsVDCGroup,
+	VDCComputePolicy:           URN.IsVDCComputePolicy,
+	Network:                    URN.IsNetwork,
+	VDCStorageProfile:          URN.IsVDCStorageProfile,
+	VAPP:                       URN.IsVAPP,
+	VAPPTemplate:               URN.IsVAPPTemplate,
+	Disk:                       URN.IsDisk,
+	SecurityGroup:              URN.IsSecurityGroup,
+	Catalog:                    URN.IsCatalog,
+	Token:                      URN.IsToken,
+	AppPortProfile:             URN.IsAppPortProfile,
+	CertificateLibraryItem:     URN.IsCertificateLibraryItem,
+	LoadBalancerPool:           URN.IsLoadBalancerPool,
+	LoadBalancerVirtualService: URN.IsLoadBalancerVirtualService,
+	ServiceEngineGroup:         URN.IsServiceEngineGroup,
+	Site:                       URN.IsSite,
+	ProviderVDC:                URN.IsProviderVDC,
+	VDCTemplate:                URN.IsVDCTemplate,
+	Role:                       URN.IsRole,
+	Right:                      URN.IsRight,
+	Task:                       URN.IsTask,
+	Entity:                     URN.IsEntity,
+	EntityType:                 URN.IsEntityType,
+	Region:                     URN.IsRegion,
+	Supervisor:                 URN.IsSupervisor,
+	VCenter:                    URN.IsVCenter,
+	VCDA:                       URN.IsVCDA,
+}
+
+func TestURN_IsOrg(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
 		{
-			name: "IsVM",
-			urn:  URN(VM.String() + validUUIDv4),
+			name: "IsOrg",
+			urn:  Org + validUUIDv4,
 			want: true,
 		},
 		{
-			name: "IsNotVM",
-			urn:  URN("urn:vcloud:user:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			name: "IsNotOrg",
+			urn:  VM + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Org + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.urn.IsVM(); got != tt.want {
-				t.Errorf("URN.IsVM() = %v, want %v", got, tt.want)
+			if got := tt.urn.IsOrg(); got != tt.want {
+				t.Errorf("URN.IsOrg() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestURN_IsUser(t *testing.T) {
+func TestURN_IsVM(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
 		{
-			name: "IsUser",
-			urn:  URN(User.String() + validUUIDv4),
+			name: "IsVM",
+			urn:  VM + validUUIDv4,
 			want: true,
 		},
 		{
-			name: "IsNotUser",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			name: "IsNotVM",
+			urn:  User + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  VM + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.urn.IsUser(); got != tt.want {
-				t.Errorf("URN.IsUser() = %v, want %v", got, tt.want)
+			if got := tt.urn.IsVM(); got != tt.want {
+				t.Errorf("URN.IsVM() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// IsGroup.
-func TestURN_IsGroup(t *testing.T) {
+func TestURN_IsUser(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
 		{
-			name: "IsGroup",
-			urn:  URN(Group.String() + validUUIDv4),
+			name: "IsUser",
+			urn:  User + validUUIDv4,
 			want: true,
 		},
 		{
-			name: "IsNotGroup",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			name: "IsNotUser",
+			urn:  Group + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  User + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.urn.IsGroup(); got != tt.want {
-				t.Errorf("URN.IsGroup() = %v, want %v", got, tt.want)
+			if got := tt.urn.IsUser(); got != tt.want {
+				t.Errorf("URN.IsUser() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// IsEdgeGateway.
-func TestURN_IsEdgeGateway(t *testing.T) {
+func TestURN_IsGroup(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
 		{
-			name: "IsEdgeGateway",
-			urn:  URN(EdgeGateway.String() + validUUIDv4),
+			name: "IsGroup",
+			urn:  Group + validUUIDv4,
 			want: true,
 		},
 		{
-			name: "IsNotEdgeGateway",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			name: "IsNotGroup",
+			urn:  EdgeGateway + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Group + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.urn.IsEdgeGateway(); got != tt.want {
-				t.Errorf("URN.IsEdgeGateway() = %v, want %v", got, tt.want)
+			if got := tt.urn.IsGroup(); got != tt.want {
+				t.Errorf("URN.IsGroup() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// IsAppPortProfile tests the IsAppProfile function.
-func TestURN_IsAppPortProfile(t *testing.T) {
+func TestURN_IsEdgeGateway(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
-		{ // IsAppProfile
-			name: "IsAppProfile",
-			urn:  URN(AppPortProfile.String() + validUUIDv4),
+		{
+			name: "IsEdgeGateway",
+			urn:  EdgeGateway + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotAppProfile
-			name: "IsNotAppProfile",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+		{
+			name: "IsNotEdgeGateway",
+			urn:  VDC + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  EdgeGateway + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.urn.IsAppPortProfile(); got != tt.want {
-				t.Errorf("URN.IsAppPortProfile() = %v, want %v", got, tt.want)
+			if got := tt.urn.IsEdgeGateway(); got != tt.want {
+				t.Errorf("URN.IsEdgeGateway() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// IsVDC.
 func TestURN_IsVDC(t *testing.T) {
 	tests := []struct {
 		name string
@@ -187,17 +238,22 @@ func TestURN_IsVDC(t *testing.T) {
 	}{
 		{
 			name: "IsVDC",
-			urn:  URN(VDC.String() + validUUIDv4),
+			urn:  VDC + validUUIDv4,
 			want: true,
 		},
 		{
 			name: "IsNotVDC",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			urn:  VDCGroup + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  VDC + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
@@ -210,26 +266,30 @@ func TestURN_IsVDC(t *testing.T) {
 	}
 }
 
-// IsVDCGroup.
 func TestURN_IsVDCGroup(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
-		{ // IsVDCGroup
+		{
 			name: "IsVDCGroup",
-			urn:  URN(VDCGroup.String() + validUUIDv4),
+			urn:  VDCGroup + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotVDCGroup
+		{
 			name: "IsNotVDCGroup",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			urn:  VDCComputePolicy + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  VDCGroup + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
@@ -242,71 +302,87 @@ func TestURN_IsVDCGroup(t *testing.T) {
 	}
 }
 
-// IsNetwork.
-func TestURN_IsNetwork(t *testing.T) {
+func TestURN_IsVDCComputePolicy(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
 		{
-			name: "IsNetwork",
-			urn:  URN(Network.String() + validUUIDv4),
+			name: "IsVDCComputePolicy",
+			urn:  VDCComputePolicy + validUUIDv4,
 			want: true,
 		},
 		{
-			name: "IsNotNetwork",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			name: "IsNotVDCComputePolicy",
+			urn:  Network + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  VDCComputePolicy + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.urn.IsNetwork(); got != tt.want {
-				t.Errorf("URN.IsNetwork() = %v, want %v", got, tt.want)
+			if got := tt.urn.IsVDCComputePolicy(); got != tt.want {
+				t.Errorf("URN.IsVDCComputePolicy() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// IsLoadBalancerPool.
-func TestURN_IsLoadBalancerPool(t *testing.T) {
+func TestURN_IsNetwork(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
 		{
-			name: "IsLoadBalancerPool",
-			urn:  URN(LoadBalancerPool.String() + validUUIDv4),
+			name: "IsNetwork",
+			urn:  Network + validUUIDv4,
 			want: true,
 		},
 		{
-			name: "IsNotLoadBalancerPool",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			name: "IsNotNetwork",
+			urn:  VDCStorageProfile + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Network + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.urn.IsLoadBalancerPool(); got != tt.want {
-				t.Errorf("URN.IsLoadBalancerPool() = %v, want %v", got, tt.want)
+			if got := tt.urn.IsNetwork(); got != tt.want {
+				t.Errorf("URN.IsNetwork() = %v, want %v", got, tt.want)
+			}
+			if got := tt.urn.IsOrgVDCNetwork(); got != tt.want {
+				t.Errorf("URN.IsOrgVDCNetwork() = %v, want %v", got, tt.want)
+			}
+			if got := tt.urn.IsExternalNetwork(); got != tt.want {
+				t.Errorf("URN.IsExternalNetwork() = %v, want %v", got, tt.want)
+			}
+			if got := tt.urn.IsVAppNetwork(); got != tt.want {
+				t.Errorf("URN.IsVAppNetwork() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// IsVDCStorageProfile.
 func TestURN_IsVDCStorageProfile(t *testing.T) {
 	tests := []struct {
 		name string
@@ -315,17 +391,22 @@ func TestURN_IsVDCStorageProfile(t *testing.T) {
 	}{
 		{
 			name: "IsVDCStorageProfile",
-			urn:  URN(VDCStorageProfile.String() + validUUIDv4),
+			urn:  VDCStorageProfile + validUUIDv4,
 			want: true,
 		},
 		{
 			name: "IsNotVDCStorageProfile",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			urn:  VAPP + validUUIDv4,
 			want: false,
 		},
-		{ // Empty string
+		{
+			name: "InvalidID",
+			urn:  VDCStorageProfile + "invalid",
+			want: false,
+		},
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
@@ -338,7 +419,6 @@ func TestURN_IsVDCStorageProfile(t *testing.T) {
 	}
 }
 
-// IsVAPP.
 func TestURN_IsVAPP(t *testing.T) {
 	tests := []struct {
 		name string
@@ -347,17 +427,22 @@ func TestURN_IsVAPP(t *testing.T) {
 	}{
 		{
 			name: "IsVAPP",
-			urn:  URN(VAPP.String() + validUUIDv4),
+			urn:  VAPP + validUUIDv4,
 			want: true,
 		},
 		{
 			name: "IsNotVAPP",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			urn:  VAPPTemplate + validUUIDv4,
 			want: false,
 		},
-		{ // Empty string
+		{
+			name: "InvalidID",
+			urn:  VAPP + "invalid",
+			want: false,
+		},
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
@@ -370,122 +455,141 @@ func TestURN_IsVAPP(t *testing.T) {
 	}
 }
 
-// IsDisk.
-func TestURN_IsDisk(t *testing.T) {
+func TestURN_IsVAPPTemplate(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
 		{
-			name: "IsDisk",
-			urn:  URN(Disk.String() + validUUIDv4),
+			name: "IsVAPPTemplate",
+			urn:  VAPPTemplate + validUUIDv4,
 			want: true,
 		},
 		{
-			name: "IsNotDisk",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			name: "IsNotVAPPTemplate",
+			urn:  Disk + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  VAPPTemplate + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.urn.IsDisk(); got != tt.want {
-				t.Errorf("URN.IsDisk() = %v, want %v", got, tt.want)
+			if got := tt.urn.IsVAPPTemplate(); got != tt.want {
+				t.Errorf("URN.IsVAPPTemplate() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// IsSecurityGroup.
-func TestURN_IsSecurityGroup(t *testing.T) {
+func TestURN_IsDisk(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
 		{
-			name: "IsSecurityGroup",
-			urn:  URN(SecurityGroup.String() + validUUIDv4),
+			name: "IsDisk",
+			urn:  Disk + validUUIDv4,
 			want: true,
 		},
 		{
-			name: "IsNotSecurityGroup",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			name: "IsNotDisk",
+			urn:  SecurityGroup + validUUIDv4,
 			want: false,
 		},
-		{ // Empty string
+		{
+			name: "InvalidID",
+			urn:  Disk + "invalid",
+			want: false,
+		},
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.urn.IsSecurityGroup(); got != tt.want {
-				t.Errorf("URN.IsSecurityGroup() = %v, want %v", got, tt.want)
+			if got := tt.urn.IsDisk(); got != tt.want {
+				t.Errorf("URN.IsDisk() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// IsVAPPTemplate.
-func TestURN_IsVAPPTemplate(t *testing.T) {
+func TestURN_IsSecurityGroup(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
-		{ // IsVAPPTemplate
-			name: "IsVAPPTemplate",
-			urn:  URN(VAPPTemplate.String() + validUUIDv4),
+		{
+			name: "IsSecurityGroup",
+			urn:  SecurityGroup + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotVAPPTemplate
-			name: "IsNotVAPPTemplate",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+		{
+			name: "IsNotSecurityGroup",
+			urn:  Catalog + validUUIDv4,
 			want: false,
 		},
-		{ // Empty string
+		{
+			name: "InvalidID",
+			urn:  SecurityGroup + "invalid",
+			want: false,
+		},
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.urn.IsVAPPTemplate(); got != tt.want {
-				t.Errorf("URN.IsVAPPTemplate() = %v, want %v", got, tt.want)
+			if got := tt.urn.IsSecurityGroup(); got != tt.want {
+				t.Errorf("URN.IsSecurityGroup() = %v, want %v", got, tt.want)
+			}
+			if got := tt.urn.IsIPSet(); got != tt.want {
+				t.Errorf("URN.IsIPSet() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// IsCatalog.
 func TestURN_IsCatalog(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
-		{ // IsCatalog
+		{
 			name: "IsCatalog",
-			urn:  URN(Catalog.String() + validUUIDv4),
+			urn:  Catalog + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotCatalog
+		{
 			name: "IsNotCatalog",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+			urn:  Token + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Catalog + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
@@ -498,26 +602,30 @@ func TestURN_IsCatalog(t *testing.T) {
 	}
 }
 
-// IsToken.
 func TestURN_IsToken(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
-		{ // IsToken
+		{
 			name: "IsToken",
-			urn:  URN(Token.String() + validUUIDv4),
+			urn:  Token + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotToken
+		{
 			name: "IsNotToken",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+			urn:  AppPortProfile + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Token + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
@@ -530,122 +638,138 @@ func TestURN_IsToken(t *testing.T) {
 	}
 }
 
-// IsCatalog.
-func TestVcloudURN_IsCatalog(t *testing.T) {
+func TestURN_IsAppPortProfile(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
-		{ // IsCatalog
-			name: "IsCatalog",
-			urn:  URN(Catalog.String() + validUUIDv4),
+		{
+			name: "IsAppPortProfile",
+			urn:  AppPortProfile + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotCatalog
-			name: "IsNotCatalog",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+		{
+			name: "IsNotAppPortProfile",
+			urn:  CertificateLibraryItem + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  AppPortProfile + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.urn.IsCatalog(); got != tt.want {
-				t.Errorf("VcloudURN.IsCatalog() = %v, want %v", got, tt.want)
+			if got := tt.urn.IsAppPortProfile(); got != tt.want {
+				t.Errorf("URN.IsAppPortProfile() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// TestURN_IsOrg tests the URN.IsOrg function.
-func TestURN_IsOrg(t *testing.T) {
+func TestURN_IsCertificateLibraryItem(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
-		{ // IsOrg
-			name: "IsOrg",
-			urn:  URN(Org.String() + validUUIDv4),
+		{
+			name: "IsCertificateLibraryItem",
+			urn:  CertificateLibraryItem + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotOrg
-			name: "IsNotOrg",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+		{
+			name: "IsNotCertificateLibraryItem",
+			urn:  LoadBalancerPool + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  CertificateLibraryItem + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.urn.IsOrg(); got != tt.want {
-				t.Errorf("URN.IsOrg() = %v, want %v", got, tt.want)
+			if got := tt.urn.IsCertificateLibraryItem(); got != tt.want {
+				t.Errorf("URN.IsCertificateLibraryItem() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// TestURN_IsVDCComputePolicy tests the URN.IsVDCComputePolicy function.
-func TestURN_IsVDCComputePolicy(t *testing.T) {
+func TestURN_IsLoadBalancerPool(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
-		{ // IsVDCComputePolicy
-			name: "IsVDCComputePolicy",
-			urn:  URN(VDCComputePolicy.String() + validUUIDv4),
+		{
+			name: "IsLoadBalancerPool",
+			urn:  LoadBalancerPool + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotVDCComputePolicy
-			name: "IsNotVDCComputePolicy",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+		{
+			name: "IsNotLoadBalancerPool",
+			urn:  LoadBalancerVirtualService + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  LoadBalancerPool + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.urn.IsVDCComputePolicy(); got != tt.want {
-				t.Errorf("URN.IsVDCComputePolicy() = %v, want %v", got, tt.want)
+			if got := tt.urn.IsLoadBalancerPool(); got != tt.want {
+				t.Errorf("URN.IsLoadBalancerPool() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// TestURN_IsLoadBalancerVirtualService tests the URN.IsLoadBalancerVirtualService function.
 func TestURN_IsLoadBalancerVirtualService(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
-		{ // IsLoadBalancerVirtualService
+		{
 			name: "IsLoadBalancerVirtualService",
-			urn:  URN(LoadBalancerVirtualService.String() + validUUIDv4),
+			urn:  LoadBalancerVirtualService + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotLoadBalancerVirtualService
+		{
 			name: "IsNotLoadBalancerVirtualService",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+			urn:  ServiceEngineGroup + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  LoadBalancerVirtualService + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
@@ -658,128 +782,469 @@ func TestURN_IsLoadBalancerVirtualService(t *testing.T) {
 	}
 }
 
-// TestURN_IsCertificateLibraryItem tests the URN.IsCertificateLibraryItem function.
-func TestURN_IsCertificateLibraryItem(t *testing.T) {
+func TestURN_IsServiceEngineGroup(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
-		{ // IsCertificateLibraryItem
-			name: "IsCertificateLibraryItem",
-			urn:  URN(CertificateLibraryItem.String() + validUUIDv4),
+		{
+			name: "IsServiceEngineGroup",
+			urn:  ServiceEngineGroup + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotCertificateLibraryItem
-			name: "IsNotCertificateLibraryItem",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+		{
+			name: "IsNotServiceEngineGroup",
+			urn:  Site + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  ServiceEngineGroup + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.urn.IsCertificateLibraryItem(); got != tt.want {
-				t.Errorf("URN.IsCertificateLibraryItem() = %v, want %v", got, tt.want)
+			if got := tt.urn.IsServiceEngineGroup(); got != tt.want {
+				t.Errorf("URN.IsServiceEngineGroup() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// TestURN_IsServiceEngineGroup tests the URN.IsServiceEngineGroup function.
-func TestURN_IsServiceEngineGroup(t *testing.T) {
+func TestURN_IsSite(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
-		{ // IsServiceEngineGroup
-			name: "IsServiceEngineGroup",
-			urn:  URN(ServiceEngineGroup.String() + validUUIDv4),
+		{
+			name: "IsSite",
+			urn:  Site + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotServiceEngineGroup
-			name: "IsNotServiceEngineGroup",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+		{
+			name: "IsNotSite",
+			urn:  ProviderVDC + validUUIDv4,
 			want: false,
 		},
-		{ // Empty string
+		{
+			name: "InvalidID",
+			urn:  Site + "invalid",
+			want: false,
+		},
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.urn.IsServiceEngineGroup(); got != tt.want {
-				t.Errorf("URN.IsServiceEngineGroup() = %v, want %v", got, tt.want)
+			if got := tt.urn.IsSite(); got != tt.want {
+				t.Errorf("URN.IsSite() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-// TestURN_IsVCDA tests the URN.IsVCDA function.
-func TestURN_IsVCDA(t *testing.T) {
+func TestURN_IsProviderVDC(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
-		{ // IsVCDA
-			name: "IsVCDA",
-			urn:  URN(VCDA.String() + validUUIDv4),
+		{
+			name: "IsProviderVDC",
+			urn:  ProviderVDC + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotVCDA
-			name: "IsNotVCDA",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+		{
+			name: "IsNotProviderVDC",
+			urn:  VDCTemplate + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  ProviderVDC + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.urn.IsVCDA(); got != tt.want {
-				t.Errorf("URN.IsVCDA() = %v, want %v", got, tt.want)
+			if got := tt.urn.IsProviderVDC(); got != tt.want {
+				t.Errorf("URN.IsProviderVDC() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestURN_IsSite(t *testing.T) {
+func TestURN_IsVDCTemplate(t *testing.T) {
 	tests := []struct {
 		name string
 		urn  URN
 		want bool
 	}{
-		{ // IsSite
-			name: "IsSite",
-			urn:  URN(Site.String() + validUUIDv4),
+		{
+			name: "IsVDCTemplate",
+			urn:  VDCTemplate + validUUIDv4,
 			want: true,
 		},
-		{ // IsNotSite
-			name: "IsNotSite",
-			urn:  URN("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+		{
+			name: "IsNotVDCTemplate",
+			urn:  Role + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  VDCTemplate + "invalid",
 			want: false,
 		},
-		{ // Empty string
+		{
 			name: "EmptyString",
-			urn:  URN(""),
+			urn:  "",
 			want: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.urn.IsSite(); got != tt.want {
-				t.Errorf("URN.IsSite() = %v, want %v", got, tt.want)
+			if got := tt.urn.IsVDCTemplate(); got != tt.want {
+				t.Errorf("URN.IsVDCTemplate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURN_IsRole(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  URN
+		want bool
+	}{
+		{
+			name: "IsRole",
+			urn:  Role + validUUIDv4,
+			want: true,
+		},
+		{
+			name: "IsNotRole",
+			urn:  Right + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Role + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.urn.IsRole(); got != tt.want {
+				t.Errorf("URN.IsRole() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURN_IsRight(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  URN
+		want bool
+	}{
+		{
+			name: "IsRight",
+			urn:  Right + validUUIDv4,
+			want: true,
+		},
+		{
+			name: "IsNotRight",
+			urn:  Task + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Right + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.urn.IsRight(); got != tt.want {
+				t.Errorf("URN.IsRight() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURN_IsTask(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  URN
+		want bool
+	}{
+		{
+			name: "IsTask",
+			urn:  Task + validUUIDv4,
+			want: true,
+		},
+		{
+			name: "IsNotTask",
+			urn:  Entity + "vmware:tkgcluster:" + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Task + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.urn.IsTask(); got != tt.want {
+				t.Errorf("URN.IsTask() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURN_IsEntity(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  URN
+		want bool
+	}{
+		{
+			name: "IsEntity",
+			urn:  Entity + "vmware:tkgcluster:" + validUUIDv4,
+			want: true,
+		},
+		{
+			name: "IsNotEntity",
+			urn:  EntityType + "vmware:tkgcluster:1.0.0",
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Entity + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.urn.IsEntity(); got != tt.want {
+				t.Errorf("URN.IsEntity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURN_IsEntityType(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  URN
+		want bool
+	}{
+		{
+			name: "IsEntityType",
+			urn:  EntityType + "vmware:tkgcluster:1.0.0",
+			want: true,
+		},
+		{
+			name: "IsNotEntityType",
+			urn:  Region + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  EntityType + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.urn.IsEntityType(); got != tt.want {
+				t.Errorf("URN.IsEntityType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURN_IsRegion(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  URN
+		want bool
+	}{
+		{
+			name: "IsRegion",
+			urn:  Region + validUUIDv4,
+			want: true,
+		},
+		{
+			name: "IsNotRegion",
+			urn:  Supervisor + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Region + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.urn.IsRegion(); got != tt.want {
+				t.Errorf("URN.IsRegion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURN_IsSupervisor(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  URN
+		want bool
+	}{
+		{
+			name: "IsSupervisor",
+			urn:  Supervisor + validUUIDv4,
+			want: true,
+		},
+		{
+			name: "IsNotSupervisor",
+			urn:  VCenter + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  Supervisor + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.urn.IsSupervisor(); got != tt.want {
+				t.Errorf("URN.IsSupervisor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURN_IsVCenter(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  URN
+		want bool
+	}{
+		{
+			name: "IsVCenter",
+			urn:  VCenter + validUUIDv4,
+			want: true,
+		},
+		{
+			name: "IsNotVCenter",
+			urn:  VCDA + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  VCenter + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.urn.IsVCenter(); got != tt.want {
+				t.Errorf("URN.IsVCenter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURN_IsVCDA(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  URN
+		want bool
+	}{
+		{
+			name: "IsVCDA",
+			urn:  VCDA + validUUIDv4,
+			want: true,
+		},
+		{
+			name: "IsNotVCDA",
+			urn:  Org + validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidID",
+			urn:  VCDA + "invalid",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.urn.IsVCDA(); got != tt.want {
+				t.Errorf("URN.IsVCDA() = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -0,0 +1,246 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Code generated by urngen from catalogue.json. DO NOT EDIT.
+
+package urn
+
+type (
+	// OrgKind identifies the Org URN type.
+	OrgKind struct{}
+	// VMKind identifies the VM URN type.
+	VMKind struct{}
+	// UserKind identifies the User URN type.
+	UserKind struct{}
+	// GroupKind identifies the Group URN type.
+	GroupKind struct{}
+	// EdgeGatewayKind identifies the EdgeGateway URN type.
+	EdgeGatewayKind struct{}
+	// VDCKind identifies the VDC URN type.
+	VDCKind struct{}
+	// VDCGroupKind identifies the VDCGroup URN type.
+	VDCGroupKind struct{}
+	// VDCComputePolicyKind identifies the VDCComputePolicy URN type.
+	VDCComputePolicyKind struct{}
+	// NetworkKind identifies the Network URN type.
+	NetworkKind struct{}
+	// VDCStorageProfileKind identifies the VDCStorageProfile URN type.
+	VDCStorageProfileKind struct{}
+	// VAPPKind identifies the VAPP URN type.
+	VAPPKind struct{}
+	// VAPPTemplateKind identifies the VAPPTemplate URN type.
+	VAPPTemplateKind struct{}
+	// DiskKind identifies the Disk URN type.
+	DiskKind struct{}
+	// SecurityGroupKind identifies the SecurityGroup URN type.
+	SecurityGroupKind struct{}
+	// CatalogKind identifies the Catalog URN type.
+	CatalogKind struct{}
+	// TokenKind identifies the Token URN type.
+	TokenKind struct{}
+	// AppPortProfileKind identifies the AppPortProfile URN type.
+	AppPortProfileKind struct{}
+	// CertificateLibraryItemKind identifies the CertificateLibraryItem URN type.
+	CertificateLibraryItemKind struct{}
+	// LoadBalancerPoolKind identifies the LoadBalancerPool URN type.
+	LoadBalancerPoolKind struct{}
+	// LoadBalancerVirtualServiceKind identifies the LoadBalancerVirtualService URN type.
+	LoadBalancerVirtualServiceKind struct{}
+	// ServiceEngineGroupKind identifies the ServiceEngineGroup URN type.
+	ServiceEngineGroupKind struct{}
+	// SiteKind identifies the Site URN type.
+	SiteKind struct{}
+	// ProviderVDCKind identifies the ProviderVDC URN type.
+	ProviderVDCKind struct{}
+	// VDCTemplateKind identifies the VDCTemplate URN type.
+	VDCTemplateKind struct{}
+	// RoleKind identifies the Role URN type.
+	RoleKind struct{}
+	// RightKind identifies the Right URN type.
+	RightKind struct{}
+	// TaskKind identifies the Task URN type.
+	TaskKind struct{}
+	// EntityKind identifies the Entity URN type.
+	EntityKind struct{}
+	// EntityTypeKind identifies the EntityType URN type.
+	EntityTypeKind struct{}
+	// RegionKind identifies the Region URN type.
+	RegionKind struct{}
+	// SupervisorKind identifies the Supervisor URN type.
+	SupervisorKind struct{}
+	// VCenterKind identifies the VCenter URN type.
+	VCenterKind struct{}
+	// VCDAKind identifies the VCDA URN type.
+	VCDAKind struct{}
+)
+
+// URNType returns Org.
+func (OrgKind) URNType() URN {
+	return Org
+}
+
+// URNType returns VM.
+func (VMKind) URNType() URN {
+	return VM
+}
+
+// URNType returns User.
+func (UserKind) URNType() URN {
+	return User
+}
+
+// URNType returns Group.
+func (GroupKind) URNType() URN {
+	return Group
+}
+
+// URNType returns EdgeGateway.
+func (EdgeGatewayKind) URNType() URN {
+	return EdgeGateway
+}
+
+// URNType returns VDC.
+func (VDCKind) URNType() URN {
+	return VDC
+}
+
+// URNType returns VDCGroup.
+func (VDCGroupKind) URNType() URN {
+	return VDCGroup
+}
+
+// URNType returns VDCComputePolicy.
+func (VDCComputePolicyKind) URNType() URN {
+	return VDCComputePolicy
+}
+
+// URNType returns Network.
+func (NetworkKind) URNType() URN {
+	return Network
+}
+
+// URNType returns VDCStorageProfile.
+func (VDCStorageProfileKind) URNType() URN {
+	return VDCStorageProfile
+}
+
+// URNType returns VAPP.
+func (VAPPKind) URNType() URN {
+	return VAPP
+}
+
+// URNType returns VAPPTemplate.
+func (VAPPTemplateKind) URNType() URN {
+	return VAPPTemplate
+}
+
+// URNType returns Disk.
+func (DiskKind) URNType() URN {
+	return Disk
+}
+
+// URNType returns SecurityGroup.
+func (SecurityGroupKind) URNType() URN {
+	return SecurityGroup
+}
+
+// URNType returns Catalog.
+func (CatalogKind) URNType() URN {
+	return Catalog
+}
+
+// URNType returns Token.
+func (TokenKind) URNType() URN {
+	return Token
+}
+
+// URNType returns AppPortProfile.
+func (AppPortProfileKind) URNType() URN {
+	return AppPortProfile
+}
+
+// URNType returns CertificateLibraryItem.
+func (CertificateLibraryItemKind) URNType() URN {
+	return CertificateLibraryItem
+}
+
+// URNType returns LoadBalancerPool.
+func (LoadBalancerPoolKind) URNType() URN {
+	return LoadBalancerPool
+}
+
+// URNType returns LoadBalancerVirtualService.
+func (LoadBalancerVirtualServiceKind) URNType() URN {
+	return LoadBalancerVirtualService
+}
+
+// URNType returns ServiceEngineGroup.
+func (ServiceEngineGroupKind) URNType() URN {
+	return ServiceEngineGroup
+}
+
+// URNType returns Site.
+func (SiteKind) URNType() URN {
+	return Site
+}
+
+// URNType returns ProviderVDC.
+func (ProviderVDCKind) URNType() URN {
+	return ProviderVDC
+}
+
+// URNType returns VDCTemplate.
+func (VDCTemplateKind) URNType() URN {
+	return VDCTemplate
+}
+
+// URNType returns Role.
+func (RoleKind) URNType() URN {
+	return Role
+}
+
+// URNType returns Right.
+func (RightKind) URNType() URN {
+	return Right
+}
+
+// URNType returns Task.
+func (TaskKind) URNType() URN {
+	return Task
+}
+
+// URNType returns Entity.
+func (EntityKind) URNType() URN {
+	return Entity
+}
+
+// URNType returns EntityType.
+func (EntityTypeKind) URNType() URN {
+	return EntityType
+}
+
+// URNType returns Region.
+func (RegionKind) URNType() URN {
+	return Region
+}
+
+// URNType returns Supervisor.
+func (SupervisorKind) URNType() URN {
+	return Supervisor
+}
+
+// URNType returns VCenter.
+func (VCenterKind) URNType() URN {
+	return VCenter
+}
+
+// URNType returns VCDA.
+func (VCDAKind) URNType() URN {
+	return VCDA
+}
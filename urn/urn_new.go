@@ -0,0 +1,153 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // SHA-1 is mandated by RFC 9562 for the name-based UUIDs (version 5).
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/orange-cloudavenue/common-go/regex"
+)
+
+// Sources of randomness and time, replaced in the tests.
+var (
+	randReader io.Reader = rand.Reader
+	timeNow              = time.Now
+)
+
+// v7State keeps the last timestamp used by NewV7 so that the IDs generated
+// by the process are strictly increasing, even within the same millisecond.
+var v7State struct {
+	mu   sync.Mutex
+	last uint64
+}
+
+// New returns a URN of the given type with a random identifier (UUID version 4)
+// read from crypto/rand.
+// Returns a *ParseError if the type is not registered or if its identifier is not a UUID (e.g. Entity).
+func New(t URN) (URN, error) {
+	if err := checkUUIDType(t); err != nil {
+		return "", err
+	}
+
+	var b [16]byte
+	if _, err := io.ReadFull(randReader, b[:]); err != nil {
+		return "", fmt.Errorf("urn: unable to generate a random UUID: %w", err)
+	}
+
+	return t + URN(formatUUIDBytes(b, 4)), nil
+}
+
+// NewV7 returns a URN of the given type with a time-ordered identifier (UUID version 7).
+// The identifiers generated by the process sort in creation order, both as strings and as bytes.
+// Returns a *ParseError if the type is not registered or if its identifier is not a UUID (e.g. Entity).
+func NewV7(t URN) (URN, error) {
+	if err := checkUUIDType(t); err != nil {
+		return "", err
+	}
+
+	var b [16]byte
+	if _, err := io.ReadFull(randReader, b[:]); err != nil {
+		return "", fmt.Errorf("urn: unable to generate a random UUID: %w", err)
+	}
+
+	// The 48 bits of unix milliseconds are followed by 12 bits of sub-millisecond
+	// precision (RFC 9562 section 6.2, method 3), bumped if the clock did not move.
+	now := timeNow()
+	ts := uint64(now.UnixMilli())<<12 | uint64(now.Nanosecond()%int(time.Millisecond))*4096/uint64(time.Millisecond)
+
+	v7State.mu.Lock()
+	if ts <= v7State.last {
+		ts = v7State.last + 1
+	}
+	v7State.last = ts
+	v7State.mu.Unlock()
+
+	ms, frac := ts>>12, ts&0xfff
+	b[0], b[1], b[2], b[3], b[4], b[5] = byte(ms>>40), byte(ms>>32), byte(ms>>24), byte(ms>>16), byte(ms>>8), byte(ms)
+	b[6], b[7] = byte(frac>>8), byte(frac)
+
+	return t + URN(formatUUIDBytes(b, 7)), nil
+}
+
+// NewFromName returns a URN of the given type with a deterministic identifier
+// derived from the namespace (a UUID) and the name, as a name-based UUID version 5 (SHA-1).
+// The same namespace and name always give the same identifier, whatever the type:
+// use a namespace per type if the same name can identify objects of different types.
+// Returns a *ParseError if the type is not registered, if its identifier is not a UUID (e.g. Entity)
+// or if the namespace is not a valid UUID.
+func NewFromName(t URN, namespace, name string) (URN, error) {
+	if err := checkUUIDType(t); err != nil {
+		return "", err
+	}
+
+	ns, ok := regex.ParseUUID(namespace, regex.UUIDDefault)
+	if !ok {
+		return "", &ParseError{Input: namespace, Err: fmt.Errorf("%w: invalid namespace", ErrInvalidUUID)}
+	}
+
+	nsBytes, _ := hex.DecodeString(strings.ReplaceAll(ns, "-", ""))
+
+	h := sha1.New() //nolint:gosec // see the import.
+	h.Write(nsBytes)
+	h.Write([]byte(name))
+
+	var b [16]byte
+	copy(b[:], h.Sum(nil))
+
+	return t + URN(formatUUIDBytes(b, 5)), nil
+}
+
+// checkUUIDType returns a *ParseError if t is not a registered type whose identifier is a UUID.
+func checkUUIDType(t URN) error {
+	info, ok := defaultRegistry.lookupURN(t.String())
+	if !ok || info.Type != t {
+		return &ParseError{Input: t.String(), Err: fmt.Errorf("%w %q", ErrUnknownType, t)}
+	}
+	if info.format != formatUUID {
+		return &ParseError{Input: t.String(), Err: fmt.Errorf("%w: a %s URN cannot be built from a UUID", ErrMissingPrefix, info.Name)}
+	}
+
+	return nil
+}
+
+// formatUUIDBytes sets the version and the RFC variant of b and returns its canonical form.
+func formatUUIDBytes(b [16]byte, version byte) string {
+	b[6] = b[6]&0x0f | version<<4
+	b[8] = b[8]&0x3f | 0x80
+
+	var buf [36]byte
+	hex.Encode(buf[0:8], b[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], b[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], b[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], b[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], b[10:])
+
+	return string(buf[:])
+}
@@ -0,0 +1,143 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/orange-cloudavenue/common-go/regex"
+)
+
+func TestNew(t *testing.T) {
+	u, err := New(VM)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if !u.IsVM() {
+		t.Errorf("New() = %v, want a VM URN", u)
+	}
+	if !regex.IsUUID(u.extractUUIDv4(VM), regex.UUIDVersion4) {
+		t.Errorf("New() = %v, want a UUID version 4", u)
+	}
+
+	other, _ := New(VM)
+	if u == other {
+		t.Errorf("New() returned %v twice", u)
+	}
+}
+
+func TestNewV7(t *testing.T) {
+	defer func(f func() time.Time) { timeNow = f }(timeNow)
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	timeNow = func() time.Time { return at }
+	v7State.last = 0
+
+	var urns []URN
+	for range 10 {
+		u, err := NewV7(VDC)
+		if err != nil {
+			t.Fatalf("NewV7() error = %v", err)
+		}
+		if !regex.IsUUID(u.extractUUIDv4(VDC), regex.UUIDVersion7) {
+			t.Fatalf("NewV7() = %v, want a UUID version 7", u)
+		}
+		urns = append(urns, u)
+	}
+
+	// The clock did not move: the identifiers are still strictly increasing.
+	if !slices.IsSorted(urns) || len(slices.Compact(slices.Clone(urns))) != len(urns) {
+		t.Errorf("NewV7() = %v, want strictly increasing URNs", urns)
+	}
+
+	// The first 48 bits are the unix milliseconds.
+	if got, want := urns[0].extractUUIDv4(VDC)[:13], "019b7ca9-8c88"; got != want {
+		t.Errorf("NewV7() timestamp = %s, want %s", got, want)
+	}
+}
+
+func TestNewFromName(t *testing.T) {
+	const namespace = "6ba7b811-9dad-11d1-80b4-00c04fd430c8" // RFC 9562 URL namespace
+
+	u, err := NewFromName(Org, namespace, "https://www.example.com/")
+	if err != nil {
+		t.Fatalf("NewFromName() error = %v", err)
+	}
+	// Same as uuid.uuid5(uuid.NAMESPACE_URL, "https://www.example.com/") in Python.
+	if want := Org + "3d3ed9d2-aa3d-5fa6-90e8-ed662e90f559"; u != want {
+		t.Errorf("NewFromName() = %v, want %v", u, want)
+	}
+
+	again, _ := NewFromName(Org, namespace, "https://www.example.com/")
+	if u != again {
+		t.Errorf("NewFromName() is not deterministic: %v != %v", u, again)
+	}
+
+	other, _ := NewFromName(Org, namespace, "https://www.example.org/")
+	if u == other {
+		t.Errorf("NewFromName() returned %v for different names", u)
+	}
+
+	if _, err := NewFromName(Org, "not-a-uuid", "name"); !errors.Is(err, ErrInvalidUUID) {
+		t.Errorf("NewFromName() error = %v, want %v", err, ErrInvalidUUID)
+	}
+}
+
+func TestNew_InvalidType(t *testing.T) {
+	tests := []struct {
+		name    string
+		t       URN
+		wantErr error
+	}{
+		{
+			name:    "Unregistered",
+			t:       URN("urn:vcloud:unknown:"),
+			wantErr: ErrUnknownType,
+		},
+		{
+			name:    "NotAPrefix",
+			t:       URN("urn:vcloud:vm:" + validUUIDv4),
+			wantErr: ErrUnknownType,
+		},
+		{
+			name:    "CompositeIdentifier",
+			t:       Entity,
+			wantErr: ErrMissingPrefix,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, fn := range map[string]func(URN) (URN, error){
+				"New":   New,
+				"NewV7": NewV7,
+				"NewFromName": func(u URN) (URN, error) {
+					return NewFromName(u, validUUIDv4, "name")
+				},
+			} {
+				var pErr *ParseError
+				if _, err := fn(tt.t); !errors.As(err, &pErr) || !errors.Is(err, tt.wantErr) {
+					t.Errorf("%s() error = %v, want %v", name, err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrEmpty is returned when the value to parse is empty.
+	ErrEmpty = errors.New("value is empty")
+	// ErrMissingPrefix is returned when the value does not start with the prefix of a registered namespace.
+	ErrMissingPrefix = errors.New("missing URN prefix (e.g. urn:vcloud:)")
+	// ErrUnknownType is returned when the URN type is not known by the package.
+	ErrUnknownType = errors.New("unknown URN type")
+	// ErrInvalidUUID is returned when the identifier part of the URN is not a valid UUID.
+	ErrInvalidUUID = errors.New("malformed UUID")
+)
+
+// ParseError is returned by Parse when the value is not a valid URN.
+// It wraps one of the sentinel errors (ErrEmpty, ErrMissingPrefix, ErrUnknownType,
+// ErrInvalidUUID or ErrInvalidSegment).
+type ParseError struct {
+	// Input is the value that failed to parse.
+	Input string
+	// Err is the underlying sentinel error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid URN %q: %v", e.Input, e.Err)
+}
+
+// Unwrap returns the underlying sentinel error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Parsed is the structured representation of a URN.
+type Parsed struct {
+	// Namespace is the URN namespace (e.g. "vcloud" or "cloudavenue").
+	Namespace string
+	// TypeName is the type segment of the URN (e.g. "gateway" for urn:vcloud:gateway:...).
+	TypeName string
+	// Type is the URN type (e.g. EdgeGateway).
+	Type URN
+	// ID is the UUID of the URN. It is empty for an EntityType URN.
+	ID string
+
+	// Vendor is the vendor of a Runtime Defined Entity (Entity and EntityType URNs only).
+	Vendor string
+	// NSS is the namespace-specific string of a Runtime Defined Entity (Entity and EntityType URNs only).
+	NSS string
+	// Version is the version of a Runtime Defined Entity type (EntityType URNs only).
+	Version string
+}
+
+// URN returns the URN built from the parsed components.
+func (p Parsed) URN() URN {
+	return p.Type + URN(segments{Vendor: p.Vendor, NSS: p.NSS, Version: p.Version, ID: p.ID}.String())
+}
+
+// Parse parses a URN string and returns its components.
+// The returned error is a *ParseError wrapping ErrEmpty, ErrMissingPrefix,
+// ErrUnknownType, ErrInvalidUUID or ErrInvalidSegment.
+func Parse(value string) (Parsed, error) {
+	if value == "" {
+		return Parsed{}, &ParseError{Input: value, Err: ErrEmpty}
+	}
+
+	namespace := ""
+	for _, ns := range defaultRegistry.namespaces() {
+		if strings.HasPrefix(value, "urn:"+ns+":") {
+			namespace = ns
+			break
+		}
+	}
+	if namespace == "" {
+		return Parsed{}, &ParseError{Input: value, Err: ErrMissingPrefix}
+	}
+
+	t, ok := defaultRegistry.lookupURN(value)
+	if !ok {
+		typeName, _, _ := strings.Cut(strings.TrimPrefix(value, "urn:"+namespace+":"), ":")
+		return Parsed{}, &ParseError{Input: value, Err: fmt.Errorf("%w %q", ErrUnknownType, typeName)}
+	}
+
+	seg, err := t.format.parse(extractUUIDv4(value, t.Type))
+	if err != nil {
+		return Parsed{}, &ParseError{Input: value, Err: err}
+	}
+
+	return Parsed{
+		Namespace: namespace,
+		TypeName:  strings.TrimSuffix(strings.TrimPrefix(t.Type.String(), "urn:"+namespace+":"), ":"),
+		Type:      t.Type,
+		ID:        seg.ID,
+		Vendor:    seg.Vendor,
+		NSS:       seg.NSS,
+		Version:   seg.Version,
+	}, nil
+}
+
+// Type returns the URN type (e.g. VM for urn:vcloud:vm:<uuid>).
+// Returns an empty URN if the URN is not valid.
+func (urn URN) Type() URN {
+	p, err := Parse(urn.String())
+	if err != nil {
+		return ""
+	}
+
+	return p.Type
+}
+
+// ID returns the UUID of the URN.
+// Returns an empty string if the URN is not valid.
+func (urn URN) ID() string {
+	p, err := Parse(urn.String())
+	if err != nil {
+		return ""
+	}
+
+	return p.ID
+}
@@ -0,0 +1,158 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    Parsed
+		wantErr error
+	}{
+		{
+			name:  "ValidVcloudURN",
+			value: EdgeGateway.String() + validUUIDv4,
+			want: Parsed{
+				Namespace: "vcloud",
+				TypeName:  "gateway",
+				Type:      EdgeGateway,
+				ID:        validUUIDv4,
+			},
+		},
+		{
+			name:  "UpperCaseUUIDIsCanonicalized",
+			value: EdgeGateway.String() + strings.ToUpper(validUUIDv4),
+			want: Parsed{
+				Namespace: "vcloud",
+				TypeName:  "gateway",
+				Type:      EdgeGateway,
+				ID:        validUUIDv4,
+			},
+		},
+		{
+			name:  "ValidCloudAvenueURN",
+			value: VCDA.String() + validUUIDv4,
+			want: Parsed{
+				Namespace: "cloudavenue",
+				TypeName:  "vcda",
+				Type:      VCDA,
+				ID:        validUUIDv4,
+			},
+		},
+		{
+			name:  "PrefixSharedWithAnotherType",
+			value: VDCGroup.String() + validUUIDv4,
+			want: Parsed{
+				Namespace: "vcloud",
+				TypeName:  "vdcGroup",
+				Type:      VDCGroup,
+				ID:        validUUIDv4,
+			},
+		},
+		{
+			name:    "EmptyString",
+			value:   "",
+			wantErr: ErrEmpty,
+		},
+		{
+			name:    "MissingPrefix",
+			value:   validUUIDv4,
+			wantErr: ErrMissingPrefix,
+		},
+		{
+			name:    "UnknownType",
+			value:   "urn:vcloud:unknown:" + validUUIDv4,
+			wantErr: ErrUnknownType,
+		},
+		{
+			name:    "MalformedUUID",
+			value:   VDC.String() + "not-a-uuid",
+			wantErr: ErrInvalidUUID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.value)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Parse() error = %v, want %v", err, tt.wantErr)
+				}
+
+				var parseErr *ParseError
+				if !errors.As(err, &parseErr) || parseErr.Input != tt.value {
+					t.Errorf("Parse() error = %v, want a *ParseError for %q", err, tt.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() unexpected error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+			if got.URN().String() != tt.value {
+				t.Errorf("Parsed.URN() = %v, want %v", got.URN(), tt.value)
+			}
+		})
+	}
+}
+
+func TestURN_TypeAndID(t *testing.T) {
+	tests := []struct {
+		name     string
+		urn      URN
+		wantType URN
+		wantID   string
+	}{
+		{
+			name:     "ValidURN",
+			urn:      URN(VM.String() + validUUIDv4),
+			wantType: VM,
+			wantID:   validUUIDv4,
+		},
+		{
+			name:     "InvalidURN",
+			urn:      URN(VM.String() + "invalid"),
+			wantType: "",
+			wantID:   "",
+		},
+		{
+			name:     "EmptyString",
+			urn:      URN(""),
+			wantType: "",
+			wantID:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.urn.Type(); got != tt.wantType {
+				t.Errorf("URN.Type() = %v, want %v", got, tt.wantType)
+			}
+			if got := tt.urn.ID(); got != tt.wantID {
+				t.Errorf("URN.ID() = %v, want %v", got, tt.wantID)
+			}
+		})
+	}
+}
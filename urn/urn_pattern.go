@@ -0,0 +1,221 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidPattern is returned when a URN pattern is malformed.
+var ErrInvalidPattern = errors.New("invalid URN pattern")
+
+// Pattern matches URNs against an expression of the form urn:<namespace>:<types>:<id> where:
+//   - <types> is a type segment (e.g. vm), a list of type segments between braces
+//     (e.g. {loadBalancerPool,loadBalancerVirtualService}) or * for all the types of the namespace;
+//   - <id> is an exact identifier or a glob (see path.Match), e.g. * for all the identifiers.
+//
+// Examples: urn:vcloud:vm:*, urn:vcloud:{vdc,vdcGroup}:*, urn:vcloud:entity:vmware:*,
+// urn:vcloud:vdc:12345678-1234-4234-9234-123456789012.
+//
+// The zero value matches nothing. Pattern implements encoding.TextMarshaler and
+// encoding.TextUnmarshaler so that it can be loaded from configuration files.
+type Pattern struct {
+	expr      string
+	namespace string
+	// types is nil when the pattern matches all the types of the namespace.
+	types []URN
+	id    string
+	glob  bool
+}
+
+// ParsePattern compiles a URN pattern.
+// The namespace and the types must be registered and an exact identifier must be valid
+// for each type. The returned error wraps ErrInvalidPattern and, for an unknown type,
+// an *UnknownTypeError.
+func ParsePattern(expr string) (Pattern, error) {
+	p := Pattern{expr: expr}
+
+	rest, ok := strings.CutPrefix(expr, "urn:")
+	if !ok {
+		return Pattern{}, fmt.Errorf("%w %q: %w", ErrInvalidPattern, expr, ErrMissingPrefix)
+	}
+
+	var typesExpr string
+	p.namespace, rest, _ = strings.Cut(rest, ":")
+	if strings.HasPrefix(rest, "{") {
+		end := strings.IndexByte(rest, '}')
+		if end < 0 || !strings.HasPrefix(rest[end+1:], ":") {
+			return Pattern{}, fmt.Errorf("%w %q: unterminated {", ErrInvalidPattern, expr)
+		}
+		typesExpr, p.id = rest[:end+1], rest[end+2:]
+	} else {
+		typesExpr, p.id, ok = strings.Cut(rest, ":")
+		if !ok {
+			return Pattern{}, fmt.Errorf("%w %q: missing identifier (e.g. urn:vcloud:vm:*)", ErrInvalidPattern, expr)
+		}
+	}
+
+	if !slices.Contains(defaultRegistry.namespaces(), p.namespace) {
+		return Pattern{}, fmt.Errorf("%w %q: %w", ErrInvalidPattern, expr, ErrMissingPrefix)
+	}
+
+	if typesExpr != "*" {
+		for _, name := range strings.Split(strings.TrimSuffix(strings.TrimPrefix(typesExpr, "{"), "}"), ",") {
+			prefix := URN("urn:" + p.namespace + ":" + name + ":")
+			t, ok := defaultRegistry.lookupURN(prefix.String())
+			if name == "" || !ok || t.Type != prefix {
+				return Pattern{}, fmt.Errorf("%w %q: %w", ErrInvalidPattern, expr,
+					&UnknownTypeError{Value: name, Suggestions: defaultRegistry.suggest(name)})
+			}
+			p.types = append(p.types, t.Type)
+		}
+	}
+
+	if p.id == "" {
+		return Pattern{}, fmt.Errorf("%w %q: missing identifier (e.g. urn:vcloud:vm:*)", ErrInvalidPattern, expr)
+	}
+
+	p.glob = strings.ContainsAny(p.id, `*?[\`)
+	if p.glob {
+		if _, err := path.Match(p.id, ""); err != nil {
+			return Pattern{}, fmt.Errorf("%w %q: %w", ErrInvalidPattern, expr, err)
+		}
+		return p, nil
+	}
+
+	// An exact identifier must be valid for the types of the pattern.
+	if p.types == nil {
+		return Pattern{}, fmt.Errorf("%w %q: an exact identifier requires a type", ErrInvalidPattern, expr)
+	}
+	for _, t := range p.types {
+		parsed, err := Parse(t.String() + p.id)
+		if err != nil {
+			return Pattern{}, fmt.Errorf("%w %q: %w", ErrInvalidPattern, expr, err)
+		}
+		p.id = strings.TrimPrefix(parsed.URN().String(), t.String())
+	}
+
+	return p, nil
+}
+
+// MustParsePattern is like ParsePattern but panics if the expression is invalid.
+func MustParsePattern(expr string) Pattern {
+	p, err := ParsePattern(expr)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
+
+// String returns the expression of the pattern.
+func (p Pattern) String() string {
+	return p.expr
+}
+
+// Match returns true if the URN is valid and matches the pattern.
+func (p Pattern) Match(u URN) bool {
+	if p.expr == "" {
+		return false
+	}
+
+	parsed, err := Parse(u.String())
+	if err != nil || parsed.Namespace != p.namespace {
+		return false
+	}
+	if p.types != nil && !slices.Contains(p.types, parsed.Type) {
+		return false
+	}
+
+	id := strings.TrimPrefix(parsed.URN().String(), parsed.Type.String())
+	if !p.glob {
+		return id == p.id
+	}
+	ok, _ := path.Match(p.id, id)
+
+	return ok
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p Pattern) MarshalText() ([]byte, error) {
+	return []byte(p.expr), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *Pattern) UnmarshalText(text []byte) error {
+	v, err := ParsePattern(string(text))
+	if err != nil {
+		return err
+	}
+
+	*p = v
+	return nil
+}
+
+// Patterns is a list of URN patterns.
+type Patterns []Pattern
+
+// ParsePatterns compiles the URN patterns.
+// The returned error joins the errors of all the invalid expressions.
+func ParsePatterns(exprs ...string) (Patterns, error) {
+	patterns := make(Patterns, 0, len(exprs))
+	var errs []error
+	for _, expr := range exprs {
+		p, err := ParsePattern(expr)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		patterns = append(patterns, p)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return patterns, nil
+}
+
+// Match returns the first pattern matching the URN.
+// Returns false if no pattern matches.
+func (ps Patterns) Match(u URN) (Pattern, bool) {
+	for _, p := range ps {
+		if p.Match(u) {
+			return p, true
+		}
+	}
+
+	return Pattern{}, false
+}
+
+// MatchAll returns all the patterns matching the URN, in order.
+func (ps Patterns) MatchAll(u URN) Patterns {
+	var matched Patterns
+	for _, p := range ps {
+		if p.Match(u) {
+			matched = append(matched, p)
+		}
+	}
+
+	return matched
+}
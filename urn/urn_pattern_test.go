@@ -0,0 +1,283 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"encoding/json"
+	"errors"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestPattern_Match(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		urn     URN
+		want    bool
+	}{
+		{
+			name:    "AllVMs",
+			pattern: "urn:vcloud:vm:*",
+			urn:     VM + validUUIDv4,
+			want:    true,
+		},
+		{
+			name:    "AllVMsOtherType",
+			pattern: "urn:vcloud:vm:*",
+			urn:     VDC + validUUIDv4,
+			want:    false,
+		},
+		{
+			name:    "AllVMsInvalidURN",
+			pattern: "urn:vcloud:vm:*",
+			urn:     VM + "not-a-uuid",
+			want:    false,
+		},
+		{
+			name:    "AlternativesFirst",
+			pattern: "urn:vcloud:{loadBalancerPool,loadBalancerVirtualService}:*",
+			urn:     LoadBalancerPool + validUUIDv4,
+			want:    true,
+		},
+		{
+			name:    "AlternativesSecond",
+			pattern: "urn:vcloud:{loadBalancerPool,loadBalancerVirtualService}:*",
+			urn:     LoadBalancerVirtualService + validUUIDv4,
+			want:    true,
+		},
+		{
+			name:    "AlternativesOther",
+			pattern: "urn:vcloud:{loadBalancerPool,loadBalancerVirtualService}:*",
+			urn:     EdgeGateway + validUUIDv4,
+			want:    false,
+		},
+		{
+			name:    "Exact",
+			pattern: "urn:vcloud:vdc:" + validUUIDv4,
+			urn:     VDC + validUUIDv4,
+			want:    true,
+		},
+		{
+			name:    "ExactIgnoresCase",
+			pattern: "urn:vcloud:vdc:" + strings.ToUpper(validUUIDv4),
+			urn:     VDC + validUUIDv4,
+			want:    true,
+		},
+		{
+			name:    "ExactOtherID",
+			pattern: "urn:vcloud:vdc:" + validUUIDv4,
+			urn:     VDC + "11111111-1111-4111-9111-111111111111",
+			want:    false,
+		},
+		{
+			name:    "AllTypesOfNamespace",
+			pattern: "urn:cloudavenue:*:*",
+			urn:     VCDA + validUUIDv4,
+			want:    true,
+		},
+		{
+			name:    "AllTypesOtherNamespace",
+			pattern: "urn:cloudavenue:*:*",
+			urn:     VM + validUUIDv4,
+			want:    false,
+		},
+		{
+			name:    "EntityVendor",
+			pattern: "urn:vcloud:entity:vmware:*",
+			urn:     "urn:vcloud:entity:vmware:tkgcluster:" + validUUIDv4,
+			want:    true,
+		},
+		{
+			name:    "EntityOtherVendor",
+			pattern: "urn:vcloud:entity:vmware:*",
+			urn:     "urn:vcloud:entity:acme:tkgcluster:" + validUUIDv4,
+			want:    false,
+		},
+		{
+			name:    "GlobPrefix",
+			pattern: "urn:vcloud:vm:12345678-*",
+			urn:     VM + validUUIDv4,
+			want:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParsePattern(tt.pattern)
+			if err != nil {
+				t.Fatalf("ParsePattern() error = %v", err)
+			}
+			if got := p.Match(tt.urn); got != tt.want {
+				t.Errorf("Match(%v) = %v, want %v", tt.urn, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePattern_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		wantErr error
+	}{
+		{
+			name:    "MissingPrefix",
+			pattern: "vcloud:vm:*",
+			wantErr: ErrMissingPrefix,
+		},
+		{
+			name:    "UnknownNamespace",
+			pattern: "urn:unknown:vm:*",
+			wantErr: ErrMissingPrefix,
+		},
+		{
+			name:    "UnknownType",
+			pattern: "urn:vcloud:vmm:*",
+			wantErr: ErrUnknownType,
+		},
+		{
+			name:    "UnknownAlternative",
+			pattern: "urn:vcloud:{loadBalancerPool,loadBalancerVirtualServices}:*",
+			wantErr: ErrUnknownType,
+		},
+		{
+			name:    "EmptyAlternative",
+			pattern: "urn:vcloud:{vdc,}:*",
+			wantErr: ErrUnknownType,
+		},
+		{
+			name:    "UnterminatedAlternatives",
+			pattern: "urn:vcloud:{vdc,vdcGroup:*",
+		},
+		{
+			name:    "MissingID",
+			pattern: "urn:vcloud:vm",
+		},
+		{
+			name:    "EmptyID",
+			pattern: "urn:vcloud:vm:",
+		},
+		{
+			name:    "InvalidExactID",
+			pattern: "urn:vcloud:vm:not-a-uuid",
+			wantErr: ErrInvalidUUID,
+		},
+		{
+			name:    "ExactIDWithoutType",
+			pattern: "urn:vcloud:*:" + validUUIDv4,
+		},
+		{
+			name:    "BadGlob",
+			pattern: "urn:vcloud:vm:[",
+			wantErr: path.ErrBadPattern,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParsePattern(tt.pattern)
+			if !errors.Is(err, ErrInvalidPattern) {
+				t.Fatalf("ParsePattern() error = %v, want %v", err, ErrInvalidPattern)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParsePattern() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParsePattern_Suggestion(t *testing.T) {
+	_, err := ParsePattern("urn:vcloud:vdcGrop:*")
+	var unknown *UnknownTypeError
+	if !errors.As(err, &unknown) || len(unknown.Suggestions) == 0 || unknown.Suggestions[0] != "vdcGroup" {
+		t.Errorf("ParsePattern() error = %v, want a suggestion for vdcGroup", err)
+	}
+}
+
+func TestPatterns_Match(t *testing.T) {
+	ps, err := ParsePatterns(
+		"urn:vcloud:vdc:"+validUUIDv4,
+		"urn:vcloud:{vdc,vdcGroup}:*",
+		"urn:vcloud:*:*",
+	)
+	if err != nil {
+		t.Fatalf("ParsePatterns() error = %v", err)
+	}
+
+	tests := []struct {
+		urn     URN
+		want    string
+		wantAll int
+	}{
+		{urn: VDC + validUUIDv4, want: "urn:vcloud:vdc:" + validUUIDv4, wantAll: 3},
+		{urn: VDCGroup + validUUIDv4, want: "urn:vcloud:{vdc,vdcGroup}:*", wantAll: 2},
+		{urn: VM + validUUIDv4, want: "urn:vcloud:*:*", wantAll: 1},
+		{urn: VCDA + validUUIDv4, want: "", wantAll: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.urn.String(), func(t *testing.T) {
+			p, ok := ps.Match(tt.urn)
+			if ok != (tt.want != "") || p.String() != tt.want {
+				t.Errorf("Match() = %q, %v, want %q", p, ok, tt.want)
+			}
+			if got := len(ps.MatchAll(tt.urn)); got != tt.wantAll {
+				t.Errorf("MatchAll() returned %d patterns, want %d", got, tt.wantAll)
+			}
+		})
+	}
+}
+
+func TestParsePatterns_Invalid(t *testing.T) {
+	_, err := ParsePatterns("urn:vcloud:vm:*", "urn:vcloud:vmm:*", "urn:vcloud:vapps:*")
+	if !errors.Is(err, ErrUnknownType) {
+		t.Fatalf("ParsePatterns() error = %v, want %v", err, ErrUnknownType)
+	}
+	if !strings.Contains(err.Error(), "vmm") || !strings.Contains(err.Error(), "vapps") {
+		t.Errorf("ParsePatterns() error = %v, want all the invalid patterns", err)
+	}
+}
+
+func TestPattern_JSON(t *testing.T) {
+	var config struct {
+		Allow Patterns `json:"allow"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"allow":["urn:vcloud:vm:*"]}`), &config); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if _, ok := config.Allow.Match(VM + validUUIDv4); !ok {
+		t.Errorf("Match() = false, want true")
+	}
+
+	b, err := json.Marshal(config)
+	if err != nil || string(b) != `{"allow":["urn:vcloud:vm:*"]}` {
+		t.Errorf("json.Marshal() = %s, %v", b, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"allow":["urn:vcloud:vmm:*"]}`), &config); !errors.Is(err, ErrInvalidPattern) {
+		t.Errorf("json.Unmarshal() error = %v, want %v", err, ErrInvalidPattern)
+	}
+
+	if (Pattern{}).Match(VM + validUUIDv4) {
+		t.Errorf("the zero Pattern matched a URN")
+	}
+}
@@ -0,0 +1,159 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+
+	"github.com/orange-cloudavenue/common-go/regex"
+)
+
+// redacted replaces the redacted part of a URN.
+const redacted = "***"
+
+type redactionMode int
+
+const (
+	redactionFull redactionMode = iota
+	redactionTypeOnly
+	redactionPseudonym
+)
+
+// RedactionPolicy defines how the URNs are written in the logs (see URN.LogValue and Redact).
+type RedactionPolicy struct {
+	mode redactionMode
+	key  []byte
+}
+
+var (
+	// RedactionFull writes the URNs as is. This is the default policy.
+	RedactionFull = RedactionPolicy{mode: redactionFull}
+
+	// RedactionTypeOnly only writes the type of the URNs (e.g. urn:vcloud:vdc:***).
+	// The bare UUIDs are written as ***.
+	RedactionTypeOnly = RedactionPolicy{mode: redactionTypeOnly}
+)
+
+// RedactionPseudonym replaces the UUIDs by pseudonyms computed with HMAC-SHA256 and the key,
+// formatted as UUIDs (version 8), e.g. urn:vcloud:vdc:<pseudonym>. The same UUID always gets
+// the same pseudonym with the same key, so that the log lines can still be correlated.
+// If the key is empty, a random key is generated: the pseudonyms are then stable for the
+// lifetime of the policy only.
+func RedactionPseudonym(key []byte) RedactionPolicy {
+	if len(key) == 0 {
+		key = make([]byte, sha256.Size)
+		_, _ = rand.Read(key)
+	}
+
+	return RedactionPolicy{mode: redactionPseudonym, key: key}
+}
+
+var redactionPolicy atomic.Pointer[RedactionPolicy]
+
+// SetRedactionPolicy sets the policy used by URN.LogValue, Of.LogValue and Redact.
+func SetRedactionPolicy(p RedactionPolicy) {
+	redactionPolicy.Store(&p)
+}
+
+// currentRedactionPolicy returns the policy set with SetRedactionPolicy, RedactionFull by default.
+func currentRedactionPolicy() RedactionPolicy {
+	if p := redactionPolicy.Load(); p != nil {
+		return *p
+	}
+
+	return RedactionFull
+}
+
+// Redact returns the URN written according to the policy.
+// A value that is neither a URN nor a UUID is fully redacted, except with RedactionFull.
+func (p RedactionPolicy) Redact(u URN) string {
+	if p.mode == redactionFull || u.isEmpty() {
+		return u.String()
+	}
+
+	parsed, err := Parse(u.String())
+	if err != nil {
+		if uuid, ok := regex.ParseUUID(u.String(), regex.UUIDDefault); ok && p.mode == redactionPseudonym {
+			return p.pseudonym(uuid)
+		}
+		return redacted
+	}
+
+	if p.mode == redactionTypeOnly {
+		return parsed.Type.String() + redacted
+	}
+
+	canonical := parsed.URN().String()
+	if parsed.ID == "" {
+		// An EntityType URN does not identify a customer object.
+		return canonical
+	}
+
+	return strings.TrimSuffix(canonical, parsed.ID) + p.pseudonym(parsed.ID)
+}
+
+// RedactText returns the text with every URN and UUID written according to the policy.
+// The UUID of an invalid URN (e.g. of an unknown type) is redacted as a bare UUID.
+// The redaction fails closed: a value that looks like a URN or a UUID but cannot be
+// validated is fully redacted.
+func (p RedactionPolicy) RedactText(text string) string {
+	if p.mode == redactionFull {
+		return text
+	}
+
+	return scannerRegex.ReplaceAllStringFunc(text, func(value string) string {
+		m, ok := matchOf(value)
+		switch {
+		case !ok:
+			return redacted
+		case m.URN != "":
+			return p.Redact(m.URN)
+		default:
+			return value[:m.Offset] + p.Redact(URN(m.UUID))
+		}
+	})
+}
+
+// pseudonym returns the pseudonym of a UUID in canonical form.
+func (p RedactionPolicy) pseudonym(uuid string) string {
+	mac := hmac.New(sha256.New, p.key)
+	mac.Write([]byte(uuid))
+
+	var b [16]byte
+	copy(b[:], mac.Sum(nil))
+
+	return formatUUIDBytes(b, 8)
+}
+
+// Redact returns the text with every URN and UUID written according to the
+// policy set with SetRedactionPolicy.
+func Redact(text string) string {
+	return currentRedactionPolicy().RedactText(text)
+}
+
+// LogValue implements slog.LogValuer.
+// The URN is written according to the policy set with SetRedactionPolicy.
+func (urn URN) LogValue() slog.Value {
+	return slog.StringValue(currentRedactionPolicy().Redact(urn))
+}
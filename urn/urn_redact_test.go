@@ -0,0 +1,164 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/orange-cloudavenue/common-go/regex"
+)
+
+func TestRedactionPolicy_Redact(t *testing.T) {
+	pseudonym := RedactionPseudonym([]byte("key"))
+	entity := URN("urn:vcloud:entity:vmware:tkgcluster:" + validUUIDv4)
+	entityType := URN("urn:vcloud:type:vmware:tkgcluster:1.0.0")
+
+	tests := []struct {
+		name   string
+		policy RedactionPolicy
+		urn    URN
+		want   string
+	}{
+		{name: "FullURN", policy: RedactionFull, urn: VDC + validUUIDv4, want: VDC.String() + validUUIDv4},
+		{name: "FullInvalid", policy: RedactionFull, urn: "invalid", want: "invalid"},
+		{name: "TypeOnlyURN", policy: RedactionTypeOnly, urn: VDC + validUUIDv4, want: "urn:vcloud:vdc:***"},
+		{name: "TypeOnlyEntity", policy: RedactionTypeOnly, urn: entity, want: "urn:vcloud:entity:***"},
+		{name: "TypeOnlyUUID", policy: RedactionTypeOnly, urn: validUUIDv4, want: "***"},
+		{name: "TypeOnlyInvalid", policy: RedactionTypeOnly, urn: "invalid", want: "***"},
+		{name: "TypeOnlyEmpty", policy: RedactionTypeOnly, urn: "", want: ""},
+		{name: "PseudonymURN", policy: pseudonym, urn: VDC + validUUIDv4, want: VDC.String() + pseudonym.pseudonym(validUUIDv4)},
+		{name: "PseudonymUpperCase", policy: pseudonym, urn: VDC + URN(strings.ToUpper(validUUIDv4)), want: VDC.String() + pseudonym.pseudonym(validUUIDv4)},
+		{name: "PseudonymEntity", policy: pseudonym, urn: entity, want: "urn:vcloud:entity:vmware:tkgcluster:" + pseudonym.pseudonym(validUUIDv4)},
+		{name: "PseudonymEntityType", policy: pseudonym, urn: entityType, want: entityType.String()},
+		{name: "PseudonymUUID", policy: pseudonym, urn: validUUIDv4, want: pseudonym.pseudonym(validUUIDv4)},
+		{name: "PseudonymInvalid", policy: pseudonym, urn: "invalid", want: "***"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Redact(tt.urn); got != tt.want {
+				t.Errorf("Redact() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactionPseudonym(t *testing.T) {
+	p := RedactionPseudonym([]byte("key"))
+
+	got := p.pseudonym(validUUIDv4)
+	if !regex.IsUUID(got, regex.UUIDAnyVersion) || got[14] != '8' {
+		t.Errorf("pseudonym() = %v, want a UUID version 8", got)
+	}
+	if got == validUUIDv4 {
+		t.Errorf("pseudonym() returned the UUID")
+	}
+	if again := RedactionPseudonym([]byte("key")).pseudonym(validUUIDv4); again != got {
+		t.Errorf("pseudonym() = %v then %v with the same key", got, again)
+	}
+	if other := RedactionPseudonym([]byte("other")).pseudonym(validUUIDv4); other == got {
+		t.Errorf("pseudonym() = %v with different keys", got)
+	}
+
+	// A random key is generated when the key is empty.
+	random := RedactionPseudonym(nil)
+	if random.pseudonym(validUUIDv4) != random.pseudonym(validUUIDv4) {
+		t.Errorf("pseudonym() is not stable with a random key")
+	}
+	if random.pseudonym(validUUIDv4) == RedactionPseudonym(nil).pseudonym(validUUIDv4) {
+		t.Errorf("two random keys gave the same pseudonym")
+	}
+}
+
+func TestRedactionPolicy_RedactText(t *testing.T) {
+	const (
+		otherUUID  = "11111111-1111-4111-9111-111111111111"
+		nonRFCUUID = "12345678-1234-f234-c234-123456789012"
+	)
+	text := "deleting " + VM.String() + validUUIDv4 + " from vdc " + strings.ToUpper(otherUUID) +
+		" (urn:vcloud:unknown:" + otherUUID + ", not-a-uuid)" +
+		// The UUIDs which are not RFC 4122/9562 UUIDs and the values which look like URNs but
+		// cannot be validated are redacted too.
+		" in urn:vcloud:vdc:" + nonRFCUUID + " and urn:vcloud:type:1.0.0"
+
+	tests := []struct {
+		name   string
+		policy RedactionPolicy
+		want   string
+	}{
+		{
+			name:   "Full",
+			policy: RedactionFull,
+			want:   text,
+		},
+		{
+			name:   "TypeOnly",
+			policy: RedactionTypeOnly,
+			want:   "deleting urn:vcloud:vm:*** from vdc *** (urn:vcloud:unknown:***, not-a-uuid) in urn:vcloud:vdc:*** and ***",
+		},
+		{
+			name:   "Pseudonym",
+			policy: RedactionPseudonym([]byte("key")),
+			want: "deleting urn:vcloud:vm:" + RedactionPseudonym([]byte("key")).pseudonym(validUUIDv4) +
+				" from vdc " + RedactionPseudonym([]byte("key")).pseudonym(otherUUID) +
+				" (urn:vcloud:unknown:" + RedactionPseudonym([]byte("key")).pseudonym(otherUUID) + ", not-a-uuid)" +
+				" in urn:vcloud:vdc:" + RedactionPseudonym([]byte("key")).pseudonym(nonRFCUUID) + " and ***",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.RedactText(text); got != tt.want {
+				t.Errorf("RedactText() =\n%v\nwant\n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURN_LogValue(t *testing.T) {
+	defer SetRedactionPolicy(RedactionFull)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	u := VDC + validUUIDv4
+	typed := MustFrom[VDCKind](u.String())
+
+	logger.Info("default", "urn", u)
+	SetRedactionPolicy(RedactionTypeOnly)
+	logger.Info("type only", "urn", u, "typed", typed)
+	logger.Info(Redact("text " + u.String()))
+
+	want := "level=INFO msg=default urn=" + u.String() + "\n" +
+		"level=INFO msg=\"type only\" urn=urn:vcloud:vdc:*** typed=urn:vcloud:vdc:***\n" +
+		"level=INFO msg=\"text urn:vcloud:vdc:***\"\n"
+	if got := buf.String(); got != want {
+		t.Errorf("log =\n%v\nwant\n%v", got, want)
+	}
+}
@@ -0,0 +1,107 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Reference is a reference to a VCD object as used by the CloudAPI:
+// {"name": "my-vdc", "id": "urn:vcloud:vdc:<uuid>"}.
+// The ID is validated when the Reference is unmarshaled (see URN.UnmarshalJSON).
+type Reference struct {
+	// Name is the name of the object. It is informative only.
+	Name string `json:"name,omitempty"`
+	// ID is the URN of the object.
+	ID URN `json:"id"`
+}
+
+// NewReference returns a Reference to the object.
+func NewReference(name string, id URN) Reference {
+	return Reference{Name: name, ID: id}
+}
+
+// String returns the name and the URN of the reference (e.g. my-vdc (urn:vcloud:vdc:<uuid>)).
+func (r Reference) String() string {
+	if r.Name == "" {
+		return r.ID.String()
+	}
+
+	return fmt.Sprintf("%s (%s)", r.Name, r.ID)
+}
+
+// Type returns the URN type of the ID. Returns an empty URN if the ID is not valid.
+func (r Reference) Type() URN {
+	return r.ID.Type()
+}
+
+// IsZero returns true if the reference has neither a name nor an ID.
+func (r Reference) IsZero() bool {
+	return r.Name == "" && r.ID.isEmpty()
+}
+
+// Validate returns a *ParseError if the ID is not a valid URN or, when
+// expected types are given, if it is not of one of them.
+func (r Reference) Validate(expected ...URN) error {
+	p, err := Parse(r.ID.String())
+	if err != nil {
+		return err
+	}
+
+	if len(expected) > 0 && !slices.Contains(expected, p.Type) {
+		names := make([]string, len(expected))
+		for i, t := range expected {
+			names[i] = t.String()
+		}
+		return &ParseError{Input: r.ID.String(), Err: fmt.Errorf("%w: got %s, expected %s", ErrUnexpectedType, p.Type, strings.Join(names, " or "))}
+	}
+
+	return nil
+}
+
+// Equal returns true if both references have the same ID, whatever their names.
+// The IDs are compared in canonical form (see Parse).
+func (r Reference) Equal(other Reference) bool {
+	return canonical(r.ID) == canonical(other.ID)
+}
+
+// ReferenceOf is a Reference whose ID is of the URN type K (see Of).
+type ReferenceOf[K Kind] struct {
+	// Name is the name of the object. It is informative only.
+	Name string `json:"name,omitempty"`
+	// ID is the URN of the object.
+	ID Of[K] `json:"id"`
+}
+
+// Reference returns the untyped reference.
+func (r ReferenceOf[K]) Reference() Reference {
+	return Reference{Name: r.Name, ID: r.ID.URN()}
+}
+
+// String returns the name and the URN of the reference (e.g. my-vdc (urn:vcloud:vdc:<uuid>)).
+func (r ReferenceOf[K]) String() string {
+	return r.Reference().String()
+}
+
+// Equal returns true if both references have the same ID, whatever their names.
+func (r ReferenceOf[K]) Equal(other ReferenceOf[K]) bool {
+	return r.Reference().Equal(other.Reference())
+}
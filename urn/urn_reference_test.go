@@ -0,0 +1,144 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestReference_JSON(t *testing.T) {
+	ref := NewReference("my-vdc", VDC+validUUIDv4)
+
+	b, err := json.Marshal(ref)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	want := `{"name":"my-vdc","id":"urn:vcloud:vdc:` + validUUIDv4 + `"}`
+	if string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+
+	var got Reference
+	if err := json.Unmarshal(b, &got); err != nil || got != ref {
+		t.Errorf("json.Unmarshal() = %v, %v, want %v", got, err, ref)
+	}
+
+	if err := json.Unmarshal([]byte(`{"name":"my-vdc","id":"urn:vcloud:vdc:invalid"}`), &got); !errors.Is(err, ErrInvalidUUID) {
+		t.Errorf("json.Unmarshal() error = %v, want %v", err, ErrInvalidUUID)
+	}
+
+	// The name is optional.
+	b, _ = json.Marshal(Reference{ID: VDC + validUUIDv4})
+	if want := `{"id":"urn:vcloud:vdc:` + validUUIDv4 + `"}`; string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+}
+
+func TestReference_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		ref      Reference
+		expected []URN
+		wantErr  error
+	}{
+		{
+			name: "AnyType",
+			ref:  NewReference("my-vdc", VDC+validUUIDv4),
+		},
+		{
+			name:     "ExpectedType",
+			ref:      NewReference("my-vdc", VDC+validUUIDv4),
+			expected: []URN{VDC},
+		},
+		{
+			name:     "OneOfExpectedTypes",
+			ref:      NewReference("my-group", VDCGroup+validUUIDv4),
+			expected: []URN{VDC, VDCGroup},
+		},
+		{
+			name:     "UnexpectedType",
+			ref:      NewReference("my-vm", VM+validUUIDv4),
+			expected: []URN{VDC, VDCGroup},
+			wantErr:  ErrUnexpectedType,
+		},
+		{
+			name:    "InvalidID",
+			ref:     NewReference("my-vdc", VDC+"invalid"),
+			wantErr: ErrInvalidUUID,
+		},
+		{
+			name:    "Empty",
+			ref:     Reference{},
+			wantErr: ErrEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.ref.Validate(tt.expected...)
+			if !errors.Is(err, tt.wantErr) || (err == nil) != (tt.wantErr == nil) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReference_Equal(t *testing.T) {
+	ref := NewReference("my-vdc", VDC+validUUIDv4)
+
+	if !ref.Equal(NewReference("renamed", VDC+URN(strings.ToUpper(validUUIDv4)))) {
+		t.Errorf("Equal() = false, want true for the same ID")
+	}
+	if ref.Equal(NewReference("my-vdc", VDCGroup+validUUIDv4)) {
+		t.Errorf("Equal() = true, want false for another ID")
+	}
+}
+
+func TestReference_String(t *testing.T) {
+	if got, want := NewReference("my-vdc", VDC+validUUIDv4).String(), "my-vdc (urn:vcloud:vdc:"+validUUIDv4+")"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+	if got, want := NewReference("", VDC+validUUIDv4).String(), "urn:vcloud:vdc:"+validUUIDv4; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+	if !(Reference{}).IsZero() || NewReference("", VDC+validUUIDv4).IsZero() {
+		t.Errorf("IsZero() returned a wrong value")
+	}
+}
+
+func TestReferenceOf(t *testing.T) {
+	var ref ReferenceOf[VDCKind]
+	if err := json.Unmarshal([]byte(`{"name":"my-vdc","id":"urn:vcloud:vdc:`+validUUIDv4+`"}`), &ref); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if got := ref.Reference(); got != NewReference("my-vdc", VDC+validUUIDv4) {
+		t.Errorf("Reference() = %v", got)
+	}
+	if !ref.Equal(ReferenceOf[VDCKind]{ID: ref.ID}) {
+		t.Errorf("Equal() = false, want true for the same ID")
+	}
+
+	err := json.Unmarshal([]byte(`{"name":"my-vm","id":"urn:vcloud:vm:`+validUUIDv4+`"}`), &ref)
+	if !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("json.Unmarshal() error = %v, want %v", err, ErrUnexpectedType)
+	}
+}
@@ -0,0 +1,413 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/orange-cloudavenue/common-go/strcase"
+)
+
+var (
+	// ErrInvalidPrefix is returned by Register when the prefix is not in the form urn:<namespace>:<type>:.
+	ErrInvalidPrefix = errors.New("invalid URN prefix")
+	// ErrAlreadyRegistered is returned by Register when the name, an alias or the prefix is already registered.
+	ErrAlreadyRegistered = errors.New("URN type already registered")
+)
+
+var prefixRegex = regexp.MustCompile(`^urn:([A-Za-z0-9][A-Za-z0-9-]*):([A-Za-z0-9]+):$`)
+
+// TypeInfo describes a registered URN type.
+type TypeInfo struct {
+	// Name is the canonical name of the URN type (e.g. "edgegateway").
+	Name string
+	// Type is the URN type, i.e. the prefix of the URN (e.g. "urn:vcloud:gateway:").
+	Type URN
+	// Aliases are the other names accepted to look up the URN type.
+	Aliases []string
+	// DisplayName is the human readable name of the URN type (e.g. "Edge Gateway").
+	DisplayName string
+	// Plural is the plural of DisplayName (e.g. "Edge Gateways").
+	Plural string
+	// MediaType is the XML media type of the URN type in the VCD legacy API
+	// (e.g. "application/vnd.vmware.admin.edgeGateway+xml"). It is empty if the
+	// URN type is not exposed by the legacy API.
+	MediaType string
+	// TerraformResource is the name of the resource of the Cloud Avenue Terraform provider
+	// managing the URN type (e.g. "cloudavenue_edgegateway"). It is empty if there is none.
+	TerraformResource string
+	// Namespace is the namespace of the URN type (e.g. "vcloud").
+	Namespace string
+	// Routes describes where the URN type is exposed in the VCD API.
+	Routes Routes
+	// Parents are the URN types that can contain a resource of this type (e.g. VAPP for VM).
+	Parents []URN
+
+	// format is the format of the part of the URN following the prefix.
+	format idFormat
+}
+
+// RegisterOption is an option of Register.
+type RegisterOption func(*TypeInfo)
+
+// WithAliases sets the aliases of the URN type.
+func WithAliases(aliases ...string) RegisterOption {
+	return func(t *TypeInfo) {
+		t.Aliases = append(t.Aliases, aliases...)
+	}
+}
+
+// WithDisplayName sets the human readable name of the URN type.
+func WithDisplayName(displayName string) RegisterOption {
+	return func(t *TypeInfo) {
+		t.DisplayName = displayName
+	}
+}
+
+// WithPlural sets the plural of the display name of the URN type.
+// By default, the plural is derived from the display name (e.g. "Policy" gives "Policies").
+func WithPlural(plural string) RegisterOption {
+	return func(t *TypeInfo) {
+		t.Plural = plural
+	}
+}
+
+// WithMediaType sets the XML media type of the URN type in the VCD legacy API.
+func WithMediaType(mediaType string) RegisterOption {
+	return func(t *TypeInfo) {
+		t.MediaType = mediaType
+	}
+}
+
+// WithTerraformResource sets the name of the Terraform resource managing the URN type.
+func WithTerraformResource(resource string) RegisterOption {
+	return func(t *TypeInfo) {
+		t.TerraformResource = resource
+	}
+}
+
+// registry stores the URN types. It is safe for concurrent use.
+type registry struct {
+	mu     sync.RWMutex
+	types  []TypeInfo
+	byKey  map[string]int
+	byType map[URN]int
+}
+
+// defaultRegistry is the registry used by the package functions.
+var defaultRegistry = newRegistry(builtinTypes)
+
+func newRegistry(types []TypeInfo) *registry {
+	r := &registry{
+		byKey:  make(map[string]int),
+		byType: make(map[URN]int),
+	}
+
+	for _, t := range types {
+		if err := r.register(t); err != nil {
+			panic(err)
+		}
+	}
+
+	return r
+}
+
+// Register adds a new URN type to the package.
+// The prefix must be in the form urn:<namespace>:<type>: (e.g. "urn:cloudavenue:backup:").
+// Once registered, the URN type is supported by IsValid, Parse, FindURNTypeFromString,
+// NormalizeStrict and the validators of the package github.com/orange-cloudavenue/common-go/validators.
+// Returns ErrInvalidPrefix if the prefix is malformed and ErrAlreadyRegistered if the name,
+// an alias or the prefix is already registered.
+func Register(name string, prefix URN, opts ...RegisterOption) error {
+	t := TypeInfo{
+		Name: name,
+		Type: prefix,
+	}
+
+	for _, opt := range opts {
+		opt(&t)
+	}
+
+	return defaultRegistry.register(t)
+}
+
+// MustRegister is like Register but panics if the URN type cannot be registered.
+func MustRegister(name string, prefix URN, opts ...RegisterOption) {
+	if err := Register(name, prefix, opts...); err != nil {
+		panic(err)
+	}
+}
+
+// Lookup returns the URN type registered with the name, an alias or the type
+// segment of the prefix (e.g. "gateway" for urn:vcloud:gateway:).
+// The lookup ignores the case and the separators, so "vdcGroup", "VDCGroup",
+// "vdc_group" and "vdc-group" all return the VDCGroup type.
+func Lookup(name string) (TypeInfo, bool) {
+	return defaultRegistry.lookup(name)
+}
+
+// Info returns the metadata of the URN type of u, which is either a URN type
+// (e.g. EdgeGateway) or a URN of that type. Returns false if the type is not registered.
+func Info(u URN) (TypeInfo, bool) {
+	return defaultRegistry.lookupURN(u.String())
+}
+
+// All returns all the registered URN types in registration order.
+func All() []TypeInfo {
+	return defaultRegistry.all()
+}
+
+func (r *registry) register(t TypeInfo) error {
+	if t.Name == "" {
+		return errors.New("URN type name is empty")
+	}
+
+	m := prefixRegex.FindStringSubmatch(t.Type.String())
+	if m == nil {
+		return fmt.Errorf("%w %q for URN type %s", ErrInvalidPrefix, t.Type, t.Name)
+	}
+
+	t.Namespace = m[1]
+	if t.DisplayName == "" {
+		t.DisplayName = t.Name
+	}
+	if t.Plural == "" {
+		t.Plural = plural(t.DisplayName)
+	}
+	t.Aliases = append([]string(nil), t.Aliases...)
+	t.Parents = append([]URN(nil), t.Parents...)
+
+	// The URN type can be looked up by its name, the type segment of its prefix and its aliases.
+	names := append([]string{t.Name, m[2]}, t.Aliases...)
+	keys := make([]string, len(names))
+	for i, name := range names {
+		keys[i] = lookupKey(name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.byType[t.Type]; ok {
+		return fmt.Errorf("%w: prefix %s", ErrAlreadyRegistered, t.Type)
+	}
+
+	for i, key := range keys {
+		if idx, ok := r.byKey[key]; ok {
+			return fmt.Errorf("%w: name %s conflicts with URN type %s", ErrAlreadyRegistered, names[i], r.types[idx].Name)
+		}
+	}
+
+	idx := len(r.types)
+	r.types = append(r.types, t)
+	r.byType[t.Type] = idx
+
+	// A type registered before its parent can create a cycle in the hierarchy.
+	if _, err := r.depths(); err != nil {
+		r.types = r.types[:idx]
+		delete(r.byType, t.Type)
+		return err
+	}
+
+	for _, key := range keys {
+		r.byKey[key] = idx
+	}
+
+	return nil
+}
+
+func (r *registry) lookup(name string) (TypeInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	idx, ok := r.byKey[lookupKey(name)]
+	if !ok {
+		return TypeInfo{}, false
+	}
+
+	return r.types[idx].clone(), true
+}
+
+// suggest returns the names of the URN types close to the name.
+func (r *registry) suggest(name string) []string {
+	key := lookupKey(name)
+	maxDistance := max(1, len(key)/3)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	type suggestion struct {
+		name     string
+		distance int
+	}
+
+	best := make(map[int]int)
+	for k, idx := range r.byKey {
+		d := levenshtein(key, k)
+		if d > maxDistance {
+			continue
+		}
+		if prev, ok := best[idx]; !ok || d < prev {
+			best[idx] = d
+		}
+	}
+
+	suggestions := make([]suggestion, 0, len(best))
+	for idx, d := range best {
+		suggestions = append(suggestions, suggestion{name: r.types[idx].Name, distance: d})
+	}
+	slices.SortFunc(suggestions, func(a, b suggestion) int {
+		if a.distance != b.distance {
+			return a.distance - b.distance
+		}
+		return strings.Compare(a.name, b.name)
+	})
+
+	names := make([]string, len(suggestions))
+	for i, s := range suggestions {
+		names[i] = s.name
+	}
+
+	return names
+}
+
+// lookupURN returns the URN type matching the beginning of the value.
+func (r *registry) lookupURN(value string) (TypeInfo, bool) {
+	// The prefix is the first three segments of the URN: urn:<namespace>:<type>:
+	end := 0
+	for i := 0; i < 3; i++ {
+		idx := strings.IndexByte(value[end:], ':')
+		if idx < 0 {
+			return TypeInfo{}, false
+		}
+		end += idx + 1
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	idx, ok := r.byType[URN(value[:end])]
+	if !ok {
+		return TypeInfo{}, false
+	}
+
+	return r.types[idx].clone(), true
+}
+
+func (r *registry) all() []TypeInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	types := make([]TypeInfo, len(r.types))
+	for i, t := range r.types {
+		types[i] = t.clone()
+	}
+
+	return types
+}
+
+// namespaces returns the list of the registered namespaces.
+func (r *registry) namespaces() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	namespaces := make([]string, 0, 2)
+	for _, t := range r.types {
+		found := false
+		for _, ns := range namespaces {
+			if ns == t.Namespace {
+				found = true
+				break
+			}
+		}
+		if !found {
+			namespaces = append(namespaces, t.Namespace)
+		}
+	}
+
+	return namespaces
+}
+
+func (r *registry) urns() []URN {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	urns := make([]URN, len(r.types))
+	for i, t := range r.types {
+		urns[i] = t.Type
+	}
+
+	return urns
+}
+
+// plural returns the English plural of the last word of the name.
+func plural(name string) string {
+	switch {
+	case name == "":
+		return ""
+	case strings.HasSuffix(name, "y") && len(name) > 1 && !strings.ContainsRune("aeiouAEIOU", rune(name[len(name)-2])):
+		return name[:len(name)-1] + "ies"
+	case strings.HasSuffix(name, "s"), strings.HasSuffix(name, "x"),
+		strings.HasSuffix(name, "ch"), strings.HasSuffix(name, "sh"):
+		return name + "es"
+	default:
+		return name + "s"
+	}
+}
+
+// lookupKey returns the key used to index a name in the registry.
+// The key is case-insensitive and ignores the separators (e.g. "vdc_group",
+// "vdc-group", "vdcGroup" and "VDCGroup" have the same key).
+func lookupKey(name string) string {
+	return strings.ReplaceAll(strcase.ToSnake(name), "_", "")
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+// clone returns a copy of the TypeInfo that does not share the slices.
+func (t TypeInfo) clone() TypeInfo {
+	t.Aliases = append([]string(nil), t.Aliases...)
+	t.Parents = append([]URN(nil), t.Parents...)
+	return t
+}
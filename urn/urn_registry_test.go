@@ -0,0 +1,230 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name    string
+		typ     string
+		prefix  URN
+		opts    []RegisterOption
+		wantErr error
+	}{
+		{
+			name:   "ValidType",
+			typ:    "testBackup",
+			prefix: "urn:cloudavenue:testBackup:",
+			opts:   []RegisterOption{WithAliases("test_backup"), WithDisplayName("Test Backup")},
+		},
+		{
+			name:    "DuplicatePrefix",
+			typ:     "otherVDC",
+			prefix:  VDC,
+			wantErr: ErrAlreadyRegistered,
+		},
+		{
+			name:    "DuplicateName",
+			typ:     "vdc",
+			prefix:  "urn:vcloud:otherVdc:",
+			wantErr: ErrAlreadyRegistered,
+		},
+		{
+			name:    "DuplicateAlias",
+			typ:     "testOther",
+			prefix:  "urn:vcloud:testOther:",
+			opts:    []RegisterOption{WithAliases("edgegateway")},
+			wantErr: ErrAlreadyRegistered,
+		},
+		{
+			name:    "MissingTrailingColon",
+			typ:     "testInvalid",
+			prefix:  "urn:vcloud:testInvalid",
+			wantErr: ErrInvalidPrefix,
+		},
+		{
+			name:    "MissingNamespace",
+			typ:     "testInvalid",
+			prefix:  "urn:testInvalid:",
+			wantErr: ErrInvalidPrefix,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Register(tt.typ, tt.prefix, tt.opts...)
+			if tt.wantErr == nil && errors.Is(err, ErrAlreadyRegistered) {
+				// The test is run multiple times (e.g. -count=2).
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Register() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// The registered type is supported everywhere.
+	u := "urn:cloudavenue:testBackup:" + validUUIDv4
+	if !IsValid(u) {
+		t.Errorf("IsValid(%s) = false, want true", u)
+	}
+	if got, err := FindURNTypeFromString("test_backup"); err != nil || got != "urn:cloudavenue:testBackup:" {
+		t.Errorf("FindURNTypeFromString() = %v, %v", got, err)
+	}
+	if got := Normalize("urn:cloudavenue:testBackup:", validUUIDv4); got.String() != u {
+		t.Errorf("Normalize() = %v, want %v", got, u)
+	}
+	if p, err := Parse(u); err != nil || p.Namespace != "cloudavenue" || p.TypeName != "testBackup" {
+		t.Errorf("Parse() = %+v, %v", p, err)
+	}
+	if info, ok := Lookup("testBackup"); !ok || info.DisplayName != "Test Backup" || info.Namespace != "cloudavenue" {
+		t.Errorf("Lookup() = %+v, %v", info, ok)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	info, ok := Lookup("edgegateway")
+	if !ok {
+		t.Fatal("Lookup(edgegateway) not found")
+	}
+	if info.Type != EdgeGateway || info.Namespace != "vcloud" || info.DisplayName != "Edge Gateway" {
+		t.Errorf("Lookup(edgegateway) = %+v", info)
+	}
+
+	if _, ok := Lookup("not-exist"); ok {
+		t.Error("Lookup(not-exist) found, want not found")
+	}
+}
+
+func TestInfo(t *testing.T) {
+	for _, u := range []URN{EdgeGateway, EdgeGateway + validUUIDv4} {
+		info, ok := Info(u)
+		if !ok {
+			t.Fatalf("Info(%s) not found", u)
+		}
+		want := TypeInfo{
+			Name:              "edgegateway",
+			Type:              EdgeGateway,
+			DisplayName:       "Edge Gateway",
+			Plural:            "Edge Gateways",
+			MediaType:         "application/vnd.vmware.admin.edgeGateway+xml",
+			TerraformResource: "cloudavenue_edgegateway",
+			Namespace:         "vcloud",
+			Routes:            Routes{CloudAPI: "1.0.0/edgeGateways", Legacy: "admin/edgeGateway"},
+			Parents:           []URN{VDC, VDCGroup},
+		}
+		if !reflect.DeepEqual(info, want) {
+			t.Errorf("Info(%s) = %+v, want %+v", u, info, want)
+		}
+	}
+
+	if _, ok := Info("urn:vcloud:unknown:"); ok {
+		t.Error("Info(urn:vcloud:unknown:) found, want not found")
+	}
+
+	// Every builtin type has a display name and a plural.
+	for _, info := range All() {
+		if info.DisplayName == "" || info.Plural == "" {
+			t.Errorf("URN type %s has no display name or plural: %+v", info.Name, info)
+		}
+	}
+}
+
+func TestRegister_Metadata(t *testing.T) {
+	MustRegister("testPolicy", "urn:cloudavenue:testPolicy:",
+		WithDisplayName("Test Policy"),
+		WithMediaType("application/vnd.test.policy+xml"),
+		WithTerraformResource("cloudavenue_test_policy"),
+	)
+	MustRegister("testBox", "urn:cloudavenue:testBox:", WithDisplayName("Test Box"), WithPlural("Test Boxen"))
+
+	info, _ := Info("urn:cloudavenue:testPolicy:")
+	if info.Plural != "Test Policies" || info.MediaType != "application/vnd.test.policy+xml" || info.TerraformResource != "cloudavenue_test_policy" {
+		t.Errorf("Info(testPolicy) = %+v", info)
+	}
+	if info, _ := Info("urn:cloudavenue:testBox:"); info.Plural != "Test Boxen" {
+		t.Errorf("Info(testBox).Plural = %q, want Test Boxen", info.Plural)
+	}
+}
+
+func TestPlural(t *testing.T) {
+	for name, want := range map[string]string{
+		"":                   "",
+		"VDC":                "VDCs",
+		"vApp":               "vApps",
+		"VDC Compute Policy": "VDC Compute Policies",
+		"Gateway":            "Gateways",
+		"Address":            "Addresses",
+		"Box":                "Boxes",
+		"Switch":             "Switches",
+	} {
+		if got := plural(name); got != want {
+			t.Errorf("plural(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestAll(t *testing.T) {
+	all := All()
+	if len(all) < len(builtinTypes) {
+		t.Fatalf("All() returned %d types, want at least %d", len(all), len(builtinTypes))
+	}
+
+	for i, bt := range builtinTypes {
+		if all[i].Type != bt.Type || all[i].Name != bt.Name {
+			t.Errorf("All()[%d] = %+v, want %+v", i, all[i], bt)
+		}
+	}
+
+	// The returned slice is a copy.
+	all[0].Name = "modified"
+	if All()[0].Name == "modified" {
+		t.Error("All() returned the internal slice")
+	}
+}
+
+func TestRegistry_Concurrency(t *testing.T) {
+	r := newRegistry(nil)
+
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			name := fmt.Sprintf("type%d", i)
+			_ = r.register(TypeInfo{Name: name, Type: URN("urn:test:" + name + ":")})
+		}()
+		go func() {
+			defer wg.Done()
+			_ = r.all()
+			_, _ = r.lookupURN("urn:test:type0:" + validUUIDv4)
+		}()
+	}
+	wg.Wait()
+
+	if got := len(r.all()); got != 50 {
+		t.Errorf("registry contains %d types, want 50", got)
+	}
+}
@@ -0,0 +1,251 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"regexp"
+
+	"github.com/orange-cloudavenue/common-go/regex"
+)
+
+const (
+	// DefaultScannerBufferSize is the default size of the buffer of a Scanner.
+	DefaultScannerBufferSize = 64 * 1024
+
+	// maxTokenSize is the maximum length of a token matched by scannerRegex.
+	// The segments of scannerRegex are bounded so that a token always fits.
+	maxTokenSize = 512
+)
+
+// scannerRegex matches the URN candidates (validated with Parse) and the bare UUIDs.
+var scannerRegex = regexp.MustCompile(
+	`urn:[A-Za-z0-9][A-Za-z0-9-]{0,31}:[A-Za-z0-9]{1,64}:` +
+		`(?:[A-Za-z0-9][A-Za-z0-9_.-]{0,63}:[A-Za-z0-9][A-Za-z0-9_.-]{0,63}:)?` +
+		`(?:` + regex.UUIDLayoutRegexString + `|[0-9]{1,9}\.[0-9]{1,9}\.[0-9]{1,9})` +
+		`|` + regex.UUIDLayoutRegexString,
+)
+
+// uuidLength is the length of a UUID in canonical form.
+const uuidLength = 36
+
+// Match is an occurrence of a URN or a bare UUID found by a Scanner.
+type Match struct {
+	// Value is the occurrence as found in the input.
+	Value string
+	// URN is the URN in canonical form. It is empty for a bare UUID.
+	URN URN
+	// Type is the URN type. It is empty for a bare UUID.
+	Type URN
+	// UUID is the UUID in canonical form. It is empty for a Runtime Defined Entity type
+	// (urn:vcloud:type:<vendor>:<nss>:<version>).
+	UUID string
+	// Offset is the byte offset of the occurrence in the input, starting at 0.
+	Offset int64
+	// Line is the line of the occurrence, starting at 1.
+	Line int
+	// Column is the byte offset of the occurrence in the line, starting at 1.
+	Column int
+}
+
+// ScannerOption configures a Scanner.
+type ScannerOption func(*Scanner)
+
+// WithBufferSize sets the size of the buffer used to read the input
+// (DefaultScannerBufferSize by default). The memory used by the Scanner is bounded
+// by this size, whatever the size of the input.
+func WithBufferSize(size int) ScannerOption {
+	return func(s *Scanner) {
+		s.buf = make([]byte, max(size, 2*maxTokenSize))
+	}
+}
+
+// Scanner finds the URNs and the bare UUIDs in a stream, e.g. a log file.
+// The occurrences split across two reads are found.
+// A URN of an unknown type or with a malformed identifier is not reported,
+// but its UUID is reported as a bare UUID if it is valid.
+//
+// Usage is similar to bufio.Scanner:
+//
+//	s := urn.NewScanner(r)
+//	for s.Scan() {
+//	    m := s.Match()
+//	    fmt.Printf("%d:%d %s %s\n", m.Line, m.Column, m.Type, m.Value)
+//	}
+//	if err := s.Err(); err != nil {
+//	    // ...
+//	}
+type Scanner struct {
+	r   io.Reader
+	buf []byte
+	// buf[start:end] is the data read and not yet discarded.
+	// base is the offset in the input of buf[0].
+	start, end int
+	base       int64
+	eof        bool
+	err        error
+
+	// Position of the last newline counted, to compute the lines and the columns.
+	counted   int64
+	line      int
+	lineStart int64
+
+	pending []Match
+	match   Match
+}
+
+// NewScanner returns a Scanner reading from r.
+func NewScanner(r io.Reader, opts ...ScannerOption) *Scanner {
+	s := &Scanner{r: r, line: 1}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.buf == nil {
+		s.buf = make([]byte, DefaultScannerBufferSize)
+	}
+
+	return s
+}
+
+// Scan advances the Scanner to the next occurrence, available with Match.
+// Returns false at the end of the input or on a read error, available with Err.
+func (s *Scanner) Scan() bool {
+	for len(s.pending) == 0 {
+		if s.eof || s.err != nil {
+			return false
+		}
+		s.fill()
+		s.find()
+	}
+
+	s.match, s.pending = s.pending[0], s.pending[1:]
+	return true
+}
+
+// Match returns the occurrence found by the last call to Scan.
+func (s *Scanner) Match() Match {
+	return s.match
+}
+
+// Err returns the first read error, except io.EOF.
+func (s *Scanner) Err() error {
+	return s.err
+}
+
+// fill discards the data already searched and reads more data.
+func (s *Scanner) fill() {
+	if s.start > 0 {
+		s.countLines(s.base + int64(s.start))
+		copy(s.buf, s.buf[s.start:s.end])
+		s.base += int64(s.start)
+		s.end -= s.start
+		s.start = 0
+	}
+
+	for s.end < len(s.buf) {
+		n, err := s.r.Read(s.buf[s.end:])
+		s.end += n
+		if errors.Is(err, io.EOF) {
+			s.eof = true
+			return
+		}
+		if err != nil {
+			s.err = err
+			return
+		}
+		if n == 0 {
+			return
+		}
+	}
+}
+
+// find searches buf[start:end] and queues the occurrences.
+// Unless the end of the input is reached, the last maxTokenSize bytes are only searched
+// after the next read, as they may contain the beginning of an occurrence.
+func (s *Scanner) find() {
+	limit := s.end
+	if !s.eof && s.err == nil {
+		limit = max(s.start, s.end-maxTokenSize)
+	}
+
+	next := limit
+	for _, loc := range scannerRegex.FindAllIndex(s.buf[s.start:s.end], -1) {
+		begin, end := s.start+loc[0], s.start+loc[1]
+		if begin >= limit {
+			break
+		}
+		s.queue(string(s.buf[begin:end]), s.base+int64(begin))
+		next = max(next, end)
+	}
+
+	s.start = next
+}
+
+// queue validates an occurrence and adds it to the pending matches.
+func (s *Scanner) queue(value string, offset int64) {
+	m, ok := matchOf(value)
+	if !ok {
+		return
+	}
+
+	m.Offset += offset
+	s.countLines(m.Offset)
+	m.Line, m.Column = s.line, int(m.Offset-s.lineStart)+1
+	s.pending = append(s.pending, m)
+}
+
+// matchOf validates a value matched by scannerRegex. The Offset of the returned
+// Match is relative to the beginning of the value.
+// Returns false if the value is neither a valid URN nor ends with a valid UUID.
+func matchOf(value string) (Match, bool) {
+	p, err := Parse(value)
+	if err == nil {
+		return Match{Value: value, URN: p.URN(), Type: p.Type, UUID: p.ID}, true
+	}
+
+	// A bare UUID, or the UUID of an invalid URN.
+	if len(value) < uuidLength {
+		return Match{}, false
+	}
+	uuid, ok := regex.ParseUUID(value[len(value)-uuidLength:], regex.UUIDDefault)
+	if !ok {
+		return Match{}, false
+	}
+
+	return Match{Value: value[len(value)-uuidLength:], UUID: uuid, Offset: int64(len(value) - uuidLength)}, true
+}
+
+// countLines counts the newlines of the input up to offset (excluded).
+// The offset must not be before the last offset counted.
+func (s *Scanner) countLines(offset int64) {
+	from := int(s.counted - s.base)
+	to := int(offset - s.base)
+	for from < to {
+		i := bytes.IndexByte(s.buf[from:to], '\n')
+		if i < 0 {
+			break
+		}
+		from += i + 1
+		s.line++
+		s.lineStart = s.base + int64(from)
+	}
+	s.counted = offset
+}
@@ -0,0 +1,139 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func scanAll(t *testing.T, s *Scanner) []Match {
+	t.Helper()
+
+	var matches []Match
+	for s.Scan() {
+		matches = append(matches, s.Match())
+	}
+	if err := s.Err(); err != nil {
+		t.Fatalf("Err() = %v", err)
+	}
+
+	return matches
+}
+
+func TestScanner(t *testing.T) {
+	const otherUUID = "11111111-1111-4111-9111-111111111111"
+	input := "GET /cloudapi/1.0.0/vdcs/" + VDC.String() + validUUIDv4 + " 200\n" +
+		"vm " + strings.ToUpper(validUUIDv4) + " powered on\n" +
+		"\n" +
+		"entity=urn:vcloud:entity:vmware:tkgcluster:" + validUUIDv4 + ", type=urn:vcloud:type:vmware:tkgcluster:1.0.0\n" +
+		"unknown urn:vcloud:unknown:" + otherUUID + " any 12345678-1234-1234-1234-123456789012"
+
+	want := []Match{
+		{
+			Value: VDC.String() + validUUIDv4, URN: VDC + validUUIDv4, Type: VDC, UUID: validUUIDv4,
+			Offset: 25, Line: 1, Column: 26,
+		},
+		{
+			Value: strings.ToUpper(validUUIDv4), UUID: validUUIDv4,
+			Offset: 84, Line: 2, Column: 4,
+		},
+		{
+			Value: "urn:vcloud:entity:vmware:tkgcluster:" + validUUIDv4, URN: "urn:vcloud:entity:vmware:tkgcluster:" + validUUIDv4,
+			Type: Entity, UUID: validUUIDv4, Offset: 140, Line: 4, Column: 8,
+		},
+		{
+			Value: "urn:vcloud:type:vmware:tkgcluster:1.0.0", URN: "urn:vcloud:type:vmware:tkgcluster:1.0.0",
+			Type: EntityType, Offset: 219, Line: 4, Column: 87,
+		},
+		{
+			Value: otherUUID, UUID: otherUUID,
+			Offset: 286, Line: 5, Column: 28,
+		},
+		{
+			Value: "12345678-1234-1234-1234-123456789012", UUID: "12345678-1234-1234-1234-123456789012",
+			Offset: 327, Line: 5, Column: 69,
+		},
+	}
+
+	got := scanAll(t, NewScanner(strings.NewReader(input)))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Scan() =\n%+v\nwant\n%+v", got, want)
+	}
+	for _, m := range got {
+		if input[m.Offset:m.Offset+int64(len(m.Value))] != m.Value {
+			t.Errorf("Offset %d does not point to %s", m.Offset, m.Value)
+		}
+	}
+}
+
+func TestScanner_BufferBoundaries(t *testing.T) {
+	// The occurrences are spread so that some of them are split across the reads.
+	var b strings.Builder
+	for i := range 200 {
+		b.WriteString(strings.Repeat("x", i%37))
+		b.WriteString(VM.String() + validUUIDv4)
+		if i%3 == 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(" " + validUUIDv4 + " ")
+	}
+	input := b.String()
+
+	want := scanAll(t, NewScanner(strings.NewReader(input), WithBufferSize(len(input)+maxTokenSize)))
+	if len(want) != 400 {
+		t.Fatalf("Scan() found %d occurrences, want 400", len(want))
+	}
+
+	for name, r := range map[string]io.Reader{
+		"SmallBuffer":   strings.NewReader(input),
+		"OneByteReader": iotest.OneByteReader(strings.NewReader(input)),
+		"HalfReader":    iotest.HalfReader(strings.NewReader(input)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			s := NewScanner(r, WithBufferSize(0))
+			if got := scanAll(t, s); !reflect.DeepEqual(got, want) {
+				t.Errorf("Scan() found %d occurrences, want %d", len(got), len(want))
+			}
+			if got := len(s.buf); got != 2*maxTokenSize {
+				t.Errorf("buffer size = %d, want %d", got, 2*maxTokenSize)
+			}
+		})
+	}
+}
+
+func TestScanner_Error(t *testing.T) {
+	errRead := errors.New("read error")
+	r := io.MultiReader(strings.NewReader(VM.String()+validUUIDv4+" "), iotest.ErrReader(errRead))
+
+	s := NewScanner(r)
+	if !s.Scan() || s.Match().URN != VM+validUUIDv4 {
+		t.Errorf("Scan() did not return the occurrence read before the error")
+	}
+	if s.Scan() {
+		t.Errorf("Scan() = true after the error")
+	}
+	if !errors.Is(s.Err(), errRead) {
+		t.Errorf("Err() = %v, want %v", s.Err(), errRead)
+	}
+}
@@ -0,0 +1,198 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// Set is an unordered collection of unique URNs.
+// The valid URNs are stored in canonical form (see Parse), so that
+// urn:vcloud:vm:<UUID> and urn:vcloud:vm:<uuid> are the same element.
+// The invalid URNs are stored as is.
+//
+// The zero value is an empty set ready to use. A nil *Set is an empty set for
+// all the methods but Add, both as receiver and as argument.
+// A Set is not safe for concurrent use by multiple goroutines.
+type Set struct {
+	m map[URN]struct{}
+}
+
+// NewSet returns a set containing the given URNs.
+func NewSet(urns ...URN) *Set {
+	s := &Set{m: make(map[URN]struct{}, len(urns))}
+	s.Add(urns...)
+
+	return s
+}
+
+// canonical returns the canonical form of a valid URN, or the URN itself.
+func canonical(u URN) URN {
+	p, err := Parse(u.String())
+	if err != nil {
+		return u
+	}
+
+	return p.URN()
+}
+
+// elements returns the elements of the set, nil for a nil set.
+func (s *Set) elements() map[URN]struct{} {
+	if s == nil {
+		return nil
+	}
+
+	return s.m
+}
+
+// Add adds the URNs to the set.
+// It panics if s is nil, as there is no set to add the URNs to.
+func (s *Set) Add(urns ...URN) {
+	if s == nil {
+		panic("urn: Add called on a nil *Set")
+	}
+	if s.m == nil {
+		s.m = make(map[URN]struct{}, len(urns))
+	}
+	for _, u := range urns {
+		s.m[canonical(u)] = struct{}{}
+	}
+}
+
+// Remove removes the URNs from the set.
+func (s *Set) Remove(urns ...URN) {
+	if s == nil {
+		return
+	}
+	for _, u := range urns {
+		delete(s.m, canonical(u))
+	}
+}
+
+// Contains returns true if the URN is in the set.
+func (s *Set) Contains(u URN) bool {
+	_, ok := s.elements()[canonical(u)]
+	return ok
+}
+
+// Len returns the number of URNs in the set.
+func (s *Set) Len() int {
+	return len(s.elements())
+}
+
+// Clone returns a copy of the set.
+func (s *Set) Clone() *Set {
+	c := &Set{m: make(map[URN]struct{}, s.Len())}
+	for u := range s.elements() {
+		c.m[u] = struct{}{}
+	}
+
+	return c
+}
+
+// Union returns a new set with the URNs that are in s or in other.
+func (s *Set) Union(other *Set) *Set {
+	u := s.Clone()
+	for v := range other.elements() {
+		u.m[v] = struct{}{}
+	}
+
+	return u
+}
+
+// Intersection returns a new set with the URNs that are in both s and other.
+func (s *Set) Intersection(other *Set) *Set {
+	i := &Set{m: make(map[URN]struct{})}
+	for v := range s.elements() {
+		if _, ok := other.elements()[v]; ok {
+			i.m[v] = struct{}{}
+		}
+	}
+
+	return i
+}
+
+// Difference returns a new set with the URNs that are in s but not in other.
+func (s *Set) Difference(other *Set) *Set {
+	d := &Set{m: make(map[URN]struct{})}
+	for v := range s.elements() {
+		if _, ok := other.elements()[v]; !ok {
+			d.m[v] = struct{}{}
+		}
+	}
+
+	return d
+}
+
+// GroupByType returns the URNs of the set grouped by URN type (e.g. VM, VDC).
+// The invalid URNs are grouped under the empty URN.
+func (s *Set) GroupByType() map[URN]*Set {
+	groups := make(map[URN]*Set)
+	for u := range s.elements() {
+		t := u.Type()
+		if groups[t] == nil {
+			groups[t] = &Set{m: make(map[URN]struct{})}
+		}
+		groups[t].m[u] = struct{}{}
+	}
+
+	return groups
+}
+
+// Sorted returns the URNs of the set sorted by type, then by UUID.
+// The invalid URNs come first, sorted as strings.
+func (s *Set) Sorted() []URN {
+	type entry struct {
+		urn URN
+		typ URN
+		id  string
+	}
+
+	entries := make([]entry, 0, s.Len())
+	for u := range s.elements() {
+		e := entry{urn: u}
+		if p, err := Parse(u.String()); err == nil {
+			e.typ, e.id = p.Type, strings.TrimPrefix(u.String(), p.Type.String())
+		}
+		entries = append(entries, e)
+	}
+
+	slices.SortFunc(entries, func(a, b entry) int {
+		return cmp.Or(
+			cmp.Compare(a.typ, b.typ),
+			cmp.Compare(a.id, b.id),
+			cmp.Compare(a.urn, b.urn),
+		)
+	})
+
+	urns := make([]URN, len(entries))
+	for i, e := range entries {
+		urns[i] = e.urn
+	}
+
+	return urns
+}
+
+// All returns an iterator over the URNs of the set, in the order of Sorted.
+func (s *Set) All() iter.Seq[URN] {
+	return slices.Values(s.Sorted())
+}
@@ -0,0 +1,163 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+const (
+	setUUID1 = "11111111-1111-4111-9111-111111111111"
+	setUUID2 = "22222222-2222-4222-9222-222222222222"
+)
+
+func TestSet(t *testing.T) {
+	var s Set // The zero value is usable.
+	s.Add(VM+setUUID2, VM+setUUID1, VM+URN(strings.ToUpper(setUUID1)))
+
+	if got := s.Len(); got != 2 {
+		t.Errorf("Len() = %d, want 2", got)
+	}
+	if !s.Contains(VM + URN(strings.ToUpper(setUUID2))) {
+		t.Errorf("Contains() = false, want true for an upper-case UUID")
+	}
+	if s.Contains(VDC + setUUID1) {
+		t.Errorf("Contains() = true, want false for another type")
+	}
+
+	s.Remove(VM + setUUID2)
+	if s.Contains(VM+setUUID2) || s.Len() != 1 {
+		t.Errorf("Remove() did not remove the URN: %v", s.Sorted())
+	}
+}
+
+func TestSet_Operations(t *testing.T) {
+	a := NewSet(VM+setUUID1, VM+setUUID2, VDC+setUUID1)
+	b := NewSet(VM+setUUID2, Org+setUUID1)
+
+	tests := []struct {
+		name string
+		got  *Set
+		want []URN
+	}{
+		{
+			name: "Union",
+			got:  a.Union(b),
+			want: []URN{Org + setUUID1, VDC + setUUID1, VM + setUUID1, VM + setUUID2},
+		},
+		{
+			name: "Intersection",
+			got:  a.Intersection(b),
+			want: []URN{VM + setUUID2},
+		},
+		{
+			name: "Difference",
+			got:  a.Difference(b),
+			want: []URN{VDC + setUUID1, VM + setUUID1},
+		},
+		{
+			name: "Clone",
+			got:  a.Clone(),
+			want: []URN{VDC + setUUID1, VM + setUUID1, VM + setUUID2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.Sorted(); !slices.Equal(got, tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+
+	// The operands are not modified.
+	if a.Len() != 3 || b.Len() != 2 {
+		t.Errorf("operations modified the operands: %v, %v", a.Sorted(), b.Sorted())
+	}
+}
+
+func TestSet_Nil(t *testing.T) {
+	var n *Set
+	a := NewSet(VM + setUUID1)
+
+	tests := []struct {
+		name string
+		got  *Set
+		want []URN
+	}{
+		{name: "Clone", got: n.Clone(), want: []URN{}},
+		{name: "Union", got: n.Union(a), want: []URN{VM + setUUID1}},
+		{name: "UnionNil", got: a.Union(nil), want: []URN{VM + setUUID1}},
+		{name: "Intersection", got: a.Intersection(nil), want: []URN{}},
+		{name: "Difference", got: a.Difference(nil), want: []URN{VM + setUUID1}},
+		{name: "DifferenceNil", got: n.Difference(a), want: []URN{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.Sorted(); !slices.Equal(got, tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+
+	n.Remove(VM + setUUID1)
+	if n.Len() != 0 || n.Contains(VM+setUUID1) || len(n.Sorted()) != 0 || len(n.GroupByType()) != 0 {
+		t.Errorf("a nil set is not empty")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Add() on a nil set did not panic")
+		}
+	}()
+	n.Add(VM + setUUID1)
+}
+
+func TestSet_GroupByType(t *testing.T) {
+	s := NewSet(VM+setUUID1, VM+setUUID2, VDC+setUUID1, "invalid")
+
+	groups := s.GroupByType()
+	if len(groups) != 3 {
+		t.Fatalf("GroupByType() returned %d groups, want 3", len(groups))
+	}
+	if got := groups[VM].Sorted(); !slices.Equal(got, []URN{VM + setUUID1, VM + setUUID2}) {
+		t.Errorf("GroupByType()[VM] = %v", got)
+	}
+	if got := groups[VDC].Sorted(); !slices.Equal(got, []URN{VDC + setUUID1}) {
+		t.Errorf("GroupByType()[VDC] = %v", got)
+	}
+	if got := groups[""].Sorted(); !slices.Equal(got, []URN{"invalid"}) {
+		t.Errorf("GroupByType()[\"\"] = %v", got)
+	}
+}
+
+func TestSet_All(t *testing.T) {
+	s := NewSet(VM+setUUID2, "invalid", Org+setUUID2, VM+setUUID1)
+	want := []URN{"invalid", Org + setUUID2, VM + setUUID1, VM + setUUID2}
+
+	// The order is deterministic.
+	for range 5 {
+		if got := slices.Collect(s.All()); !slices.Equal(got, want) {
+			t.Fatalf("All() = %v, want %v", got, want)
+		}
+	}
+}
@@ -19,7 +19,10 @@
 package urn
 
 import (
+	"regexp"
 	"testing"
+
+	"github.com/orange-cloudavenue/common-go/regex"
 )
 
 const (
@@ -95,8 +98,8 @@ func Test_isURNV4(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := isUUIDV4(tt.args.urn); got != tt.want {
-				t.Errorf("isUUIDV4() = %v, want %v", got, tt.want)
+			if got := isUUID(tt.args.urn); got != tt.want {
+				t.Errorf("isUUID() = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -240,6 +243,11 @@ func TestIsUUIDV4(t *testing.T) {
 			urn:  validUUIDv4,
 			want: true,
 		},
+		{
+			name: "UpperCase",
+			urn:  "ABCDEF12-1234-4234-9234-123456789012",
+			want: false,
+		},
 		{
 			name: "InvalidUUIDV4",
 			urn:  "12345678-1234-1234-1234-12345678901Z",
@@ -264,3 +272,54 @@ func TestIsUUIDV4(t *testing.T) {
 		})
 	}
 }
+
+func TestIsStrictUUIDV4(t *testing.T) {
+	uuid4 := regexp.MustCompile("^" + regex.UUID4RegexString + "$")
+
+	tests := []struct {
+		name string
+		urn  string
+		want bool
+	}{
+		{
+			name: "ValidUUIDV4",
+			urn:  "12345678-1234-4234-9234-123456789012",
+			want: true,
+		},
+		{ // The version and the variant are checked, like regex.UUID4RegexString
+			name: "NotVersion4",
+			urn:  validUUIDv4,
+			want: false,
+		},
+		{
+			name: "InvalidVariant",
+			urn:  "12345678-1234-4234-1234-123456789012",
+			want: false,
+		},
+		{
+			name: "UpperCase",
+			urn:  "ABCDEF12-1234-4234-9234-123456789012",
+			want: false,
+		},
+		{
+			name: "InvalidFormat",
+			urn:  "12345678-1234-4234-9234-1234567890123",
+			want: false,
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsStrictUUIDV4(tt.urn); got != tt.want {
+				t.Errorf("IsStrictUUIDV4() = %v, want %v", got, tt.want)
+			}
+			if got := uuid4.MatchString(tt.urn); got != tt.want {
+				t.Errorf("regex.UUID4RegexString match = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+)
+
+// Kind identifies a URN type at compile time. It is used as the type parameter of Of.
+type Kind interface {
+	// URNType returns the URN type (e.g. VDC).
+	URNType() URN
+}
+
+// Of is a URN that is guaranteed to be of the type identified by the kind K.
+// Use From to create it from a string, it rejects the URNs of another type.
+// An Of can be converted to URN or string:
+//
+//	func DeleteVDC(id urn.Of[urn.VDCKind]) error {
+//	    return client.Delete(string(id))
+//	}
+//
+//	id, err := urn.From[urn.VDCKind]("urn:vcloud:vdc:12345678-1234-4234-9234-123456789012")
+type Of[K Kind] URN
+
+// From returns the value as an Of[K].
+// Returns a *ParseError if the value is not a valid URN of the type identified by K.
+// The bare UUIDs are rejected, as by UnmarshalText, and so is the empty value.
+func From[K Kind](value string) (Of[K], error) {
+	var k K
+	u, err := Decode(value, WithExpectedType(k.URNType()))
+	if err != nil {
+		return "", err
+	}
+	if u.isEmpty() {
+		return "", &ParseError{Input: value, Err: ErrEmpty}
+	}
+
+	return Of[K](u), nil
+}
+
+// MustFrom is like From but panics if the value is not a valid URN of the type identified by K.
+func MustFrom[K Kind](value string) Of[K] {
+	u, err := From[K](value)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
+
+// URN returns the URN.
+func (o Of[K]) URN() URN {
+	return URN(o)
+}
+
+// String returns the string representation of the URN.
+func (o Of[K]) String() string {
+	return string(o)
+}
+
+// Type returns the URN type identified by K.
+func (o Of[K]) Type() URN {
+	var k K
+	return k.URNType()
+}
+
+// ID returns the UUID of the URN.
+func (o Of[K]) ID() string {
+	return URN(o).ID()
+}
+
+// IsValid returns true if the URN is a valid URN of the type identified by K.
+func (o Of[K]) IsValid() bool {
+	return URN(o).IsType(o.Type())
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (o Of[K]) MarshalText() ([]byte, error) {
+	return []byte(o), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// The text must be empty (the zero Of) or a valid URN of the type identified by K.
+// Like From and URN.UnmarshalText, the bare UUIDs are rejected: use Lenient[K]
+// to accept the legacy values.
+func (o *Of[K]) UnmarshalText(text []byte) error {
+	u, err := Decode(string(text), WithExpectedType(o.Type()))
+	if err != nil {
+		return err
+	}
+
+	*o = Of[K](u)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o Of[K]) MarshalJSON() ([]byte, error) {
+	return URN(o).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// See UnmarshalText for the accepted values.
+func (o *Of[K]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	return o.UnmarshalText([]byte(s))
+}
+
+// Scan implements sql.Scanner.
+// See UnmarshalText for the accepted values.
+func (o *Of[K]) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*o = ""
+		return nil
+	case string:
+		return o.UnmarshalText([]byte(v))
+	case []byte:
+		return o.UnmarshalText(v)
+	default:
+		return fmt.Errorf("cannot scan %T into urn.Of[%s]", src, o.Type())
+	}
+}
+
+// Value implements driver.Valuer.
+func (o Of[K]) Value() (driver.Value, error) {
+	return URN(o).Value()
+}
+
+// LogValue implements slog.LogValuer (see SetRedactionPolicy).
+func (o Of[K]) LogValue() slog.Value {
+	return URN(o).LogValue()
+}
+
+// Lenient is an Of[K] that also accepts the bare UUIDs when it is decoded (text, JSON
+// or database): they are normalized as URNs of the type identified by K (see
+// WithBareUUID). Use it for the fields of the legacy payloads and rows:
+//
+//	type Request struct {
+//	    VDC urn.Lenient[urn.VDCKind] `json:"vdc"`
+//	}
+//
+// It is always encoded as a URN.
+type Lenient[K Kind] Of[K]
+
+// Of returns the URN as an Of[K].
+func (l Lenient[K]) Of() Of[K] {
+	return Of[K](l)
+}
+
+// URN returns the URN.
+func (l Lenient[K]) URN() URN {
+	return URN(l)
+}
+
+// String returns the string representation of the URN.
+func (l Lenient[K]) String() string {
+	return string(l)
+}
+
+// Type returns the URN type identified by K.
+func (l Lenient[K]) Type() URN {
+	return l.Of().Type()
+}
+
+// ID returns the UUID of the URN.
+func (l Lenient[K]) ID() string {
+	return URN(l).ID()
+}
+
+// IsValid returns true if the URN is a valid URN of the type identified by K.
+func (l Lenient[K]) IsValid() bool {
+	return l.Of().IsValid()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (l Lenient[K]) MarshalText() ([]byte, error) {
+	return []byte(l), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// The text must be empty (the zero Lenient), a valid URN of the type identified by K
+// or a bare UUID.
+func (l *Lenient[K]) UnmarshalText(text []byte) error {
+	u, err := Decode(string(text), WithBareUUID(l.Type()))
+	if err != nil {
+		return err
+	}
+
+	*l = Lenient[K](u)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (l Lenient[K]) MarshalJSON() ([]byte, error) {
+	return URN(l).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// See UnmarshalText for the accepted values.
+func (l *Lenient[K]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	return l.UnmarshalText([]byte(s))
+}
+
+// Scan implements sql.Scanner.
+// See UnmarshalText for the accepted values.
+func (l *Lenient[K]) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*l = ""
+		return nil
+	case string:
+		return l.UnmarshalText([]byte(v))
+	case []byte:
+		return l.UnmarshalText(v)
+	default:
+		return fmt.Errorf("cannot scan %T into urn.Lenient[%s]", src, l.Type())
+	}
+}
+
+// Value implements driver.Valuer.
+func (l Lenient[K]) Value() (driver.Value, error) {
+	return URN(l).Value()
+}
+
+// LogValue implements slog.LogValuer (see SetRedactionPolicy).
+func (l Lenient[K]) LogValue() slog.Value {
+	return URN(l).LogValue()
+}
@@ -0,0 +1,263 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package urn
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestFrom(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr error
+	}{
+		{
+			name:  "ValidVDC",
+			value: VDC.String() + validUUIDv4,
+		},
+		{
+			name:    "OtherType",
+			value:   VDCGroup.String() + validUUIDv4,
+			wantErr: ErrUnexpectedType,
+		},
+		{
+			name:    "InvalidUUID",
+			value:   VDC.String() + "invalid",
+			wantErr: ErrInvalidUUID,
+		},
+		{
+			name:    "EmptyString",
+			value:   "",
+			wantErr: ErrEmpty,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := From[VDCKind](tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("From() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if string(got) != tt.value || got.URN() != URN(tt.value) {
+				t.Errorf("From() = %v, want %v", got, tt.value)
+			}
+			if !got.IsValid() || got.Type() != VDC || got.ID() != validUUIDv4 {
+				t.Errorf("From() = %v, IsValid() = %v, Type() = %v, ID() = %v", got, got.IsValid(), got.Type(), got.ID())
+			}
+		})
+	}
+}
+
+func TestMustFrom(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MustFrom() did not panic")
+		}
+	}()
+
+	MustFrom[VMKind](VDC.String() + validUUIDv4)
+}
+
+func TestOf_JSON(t *testing.T) {
+	type payload struct {
+		VDC Of[VDCKind] `json:"vdc"`
+	}
+
+	u := VDC.String() + validUUIDv4
+
+	var got payload
+	if err := json.Unmarshal([]byte(`{"vdc":"`+u+`"}`), &got); err != nil || got.VDC.String() != u {
+		t.Errorf("json.Unmarshal() = %v, %v, want %v", got.VDC, err, u)
+	}
+
+	// Bare UUIDs are rejected, as by From.
+	if err := json.Unmarshal([]byte(`{"vdc":"`+validUUIDv4+`"}`), &got); !errors.Is(err, ErrMissingPrefix) {
+		t.Errorf("json.Unmarshal() error = %v, want %v", err, ErrMissingPrefix)
+	}
+
+	if err := json.Unmarshal([]byte(`{"vdc":"`+VM.String()+validUUIDv4+`"}`), &got); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("json.Unmarshal() error = %v, want %v", err, ErrUnexpectedType)
+	}
+
+	data, err := json.Marshal(payload{VDC: MustFrom[VDCKind](u)})
+	if err != nil || string(data) != `{"vdc":"`+u+`"}` {
+		t.Errorf("json.Marshal() = %s, %v", data, err)
+	}
+}
+
+func TestOf_SQL(t *testing.T) {
+	var got Of[VMKind]
+	if err := got.Scan(VM.String() + validUUIDv4); err != nil || got.String() != VM.String()+validUUIDv4 {
+		t.Errorf("Of.Scan() = %v, %v", got, err)
+	}
+	if err := got.Scan(validUUIDv4); err == nil {
+		t.Error("Of.Scan() accepted a bare UUID")
+	}
+	if err := got.Scan(VDC.String() + validUUIDv4); err == nil {
+		t.Error("Of.Scan() accepted a VDC URN")
+	}
+	if v, err := got.Value(); err != nil || v != VM.String()+validUUIDv4 {
+		t.Errorf("Of.Value() = %v, %v", v, err)
+	}
+}
+
+func TestLenient(t *testing.T) {
+	type row struct {
+		VDC Lenient[VDCKind] `json:"vdc"`
+	}
+
+	u := VDC.String() + validUUIDv4
+
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		wantErr error
+	}{
+		{
+			name: "URN",
+			data: `{"vdc":"` + u + `"}`,
+			want: u,
+		},
+		{
+			name: "BareUUID",
+			data: `{"vdc":"` + validUUIDv4 + `"}`,
+			want: u,
+		},
+		{
+			name: "UpperCaseBareUUID",
+			data: `{"vdc":"` + strings.ToUpper(validUUIDv4) + `"}`,
+			want: u,
+		},
+		{
+			name: "Null",
+			data: `{"vdc":null}`,
+		},
+		{
+			name:    "OtherType",
+			data:    `{"vdc":"` + VM.String() + validUUIDv4 + `"}`,
+			wantErr: ErrUnexpectedType,
+		},
+		{
+			name:    "InvalidUUID",
+			data:    `{"vdc":"invalid"}`,
+			wantErr: ErrMissingPrefix,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got row
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("json.Unmarshal() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.VDC.String() != tt.want {
+				t.Errorf("json.Unmarshal() = %v, want %v", got.VDC, tt.want)
+			}
+		})
+	}
+
+	var got Lenient[VDCKind]
+	if err := got.Scan([]byte(validUUIDv4)); err != nil || got.Of() != MustFrom[VDCKind](u) {
+		t.Errorf("Lenient.Scan() = %v, %v, want %v", got, err, u)
+	}
+	if err := got.Scan(VM.String() + validUUIDv4); err == nil {
+		t.Error("Lenient.Scan() accepted a VM URN")
+	}
+	if v, err := got.Value(); err != nil || v != u {
+		t.Errorf("Lenient.Value() = %v, %v", v, err)
+	}
+
+	data, err := json.Marshal(row{VDC: got})
+	if err != nil || string(data) != `{"vdc":"`+u+`"}` {
+		t.Errorf("json.Marshal() = %s, %v", data, err)
+	}
+}
+
+// TestOf_DecodingRules checks that From, Of.UnmarshalText and URN.UnmarshalText
+// accept and reject the same non-empty values.
+func TestOf_DecodingRules(t *testing.T) {
+	values := []string{
+		VDC.String() + validUUIDv4,
+		strings.ToUpper(VDC.String() + validUUIDv4),
+		validUUIDv4,
+		VM.String() + validUUIDv4,
+		VDC.String() + "invalid",
+		"invalid",
+	}
+
+	for _, value := range values {
+		_, fromErr := From[VDCKind](value)
+
+		var o Of[VDCKind]
+		ofErr := o.UnmarshalText([]byte(value))
+
+		var u URN
+		urnErr := u.UnmarshalText([]byte(value))
+		if urnErr == nil && u.Type() != VDC {
+			urnErr = ErrUnexpectedType
+		}
+
+		if (fromErr == nil) != (ofErr == nil) || (fromErr == nil) != (urnErr == nil) {
+			t.Errorf("%q: From() error = %v, Of.UnmarshalText() error = %v, URN.UnmarshalText() error = %v", value, fromErr, ofErr, urnErr)
+		}
+	}
+
+	// The codecs decode the empty value as the zero value, From rejects it.
+	var o Of[VDCKind]
+	if err := o.UnmarshalText(nil); err != nil || o != "" {
+		t.Errorf("Of.UnmarshalText() = %v, %v, want the zero value", o, err)
+	}
+	if _, err := From[VDCKind](""); !errors.Is(err, ErrEmpty) {
+		t.Errorf("From() error = %v, want %v", err, ErrEmpty)
+	}
+}
+
+func TestKinds(t *testing.T) {
+	kinds := []Kind{
+		OrgKind{}, VMKind{}, UserKind{}, GroupKind{}, EdgeGatewayKind{}, VDCKind{}, VDCGroupKind{},
+		VDCComputePolicyKind{}, NetworkKind{}, VDCStorageProfileKind{}, VAPPKind{}, VAPPTemplateKind{},
+		DiskKind{}, SecurityGroupKind{}, CatalogKind{}, TokenKind{}, AppPortProfileKind{},
+		CertificateLibraryItemKind{}, LoadBalancerPoolKind{}, LoadBalancerVirtualServiceKind{},
+		ServiceEngineGroupKind{}, SiteKind{}, ProviderVDCKind{}, VDCTemplateKind{}, RoleKind{}, RightKind{},
+		TaskKind{}, RegionKind{}, SupervisorKind{}, VCenterKind{}, VCDAKind{}, EntityKind{}, EntityTypeKind{},
+	}
+
+	seen := make(map[URN]bool)
+	for _, k := range kinds {
+		if _, ok := defaultRegistry.lookupURN(k.URNType().String()); !ok {
+			t.Errorf("%T.URNType() = %v is not registered", k, k.URNType())
+		}
+		seen[k.URNType()] = true
+	}
+	for _, bt := range builtinTypes {
+		if !seen[bt.Type] {
+			t.Errorf("no Kind for the URN type %s", bt.Type)
+		}
+	}
+}
@@ -20,7 +20,9 @@ package utils
 
 import (
 	"fmt"
-	"regexp"
+	"strings"
+
+	"github.com/orange-cloudavenue/common-go/regex"
 )
 
 var (
@@ -28,8 +30,12 @@ var (
 	ErrEntryIsEmtpy = fmt.Errorf("entry is empty")
 )
 
+// uuidLength is the length of a UUID in canonical form.
+const uuidLength = 36
+
 // GetUUIDFromHref returns the UUID from an href
 // idAtEnd is true if the UUID is at the end of the href
+// The UUID is validated with regex.UUIDDefault and returned in canonical form (lower case).
 // if href is empty, an error is returned (ErrEntryIsEmtpy)
 // if no match is found, an error is returned (ErrNoMatch)
 func GetUUIDFromHref(href string, idAtEnd bool) (string, error) {
@@ -37,20 +43,24 @@ func GetUUIDFromHref(href string, idAtEnd bool) (string, error) {
 		return "", ErrEntryIsEmtpy
 	}
 
-	regex := `:\/\/.+([a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12})`
+	_, path, ok := strings.Cut(href, "://")
+	// The UUID is preceded by at least one character (the host).
+	if !ok || len(path) <= uuidLength {
+		return "", ErrNoMatch
+	}
 
 	if idAtEnd {
-		regex += `$`
-	} else {
-		regex += `.*$`
+		if id, ok := regex.ParseUUID(path[len(path)-uuidLength:], regex.UUIDDefault); ok {
+			return id, nil
+		}
+		return "", ErrNoMatch
 	}
 
-	reGetID := regexp.MustCompile(regex)
-	matchList := reGetID.FindAllStringSubmatch(href, -1)
-
-	if len(matchList) == 0 {
+	// Return the last UUID of the href.
+	uuids := regex.FindUUIDs(path[1:], regex.UUIDDefault)
+	if len(uuids) == 0 {
 		return "", ErrNoMatch
 	}
 
-	return matchList[0][1], nil
+	return uuids[len(uuids)-1], nil
 }
@@ -51,6 +51,20 @@ func TestGetUUIDFromHref(t *testing.T) {
 			expectedID:  "",
 			expectedErr: ErrNoMatch,
 		},
+		{
+			name:        "Upper case ID is canonicalized",
+			href:        "https://example.com/resource/123E4567-E89B-12D3-A456-426614174000",
+			idAtEnd:     true,
+			expectedID:  "123e4567-e89b-12d3-a456-426614174000",
+			expectedErr: nil,
+		},
+		{
+			name:        "Any variant",
+			href:        "https://example.com/resource/123e4567-e89b-12d3-c456-426614174000",
+			idAtEnd:     true,
+			expectedID:  "123e4567-e89b-12d3-c456-426614174000",
+			expectedErr: nil,
+		},
 		{
 			name:        "Empty href",
 			href:        "",
@@ -1,8 +1,11 @@
 module github.com/orange-cloudavenue/common-go/utils
 
-go 1.20
+go 1.25.0
 
-require github.com/stretchr/testify v1.10.0
+require (
+	github.com/orange-cloudavenue/common-go/regex v1.3.0
+	github.com/stretchr/testify v1.10.0
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
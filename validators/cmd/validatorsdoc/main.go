@@ -0,0 +1,159 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Command validatorsdoc generates the Markdown reference of struct types from
+// their validate, default and description tags with validators.Markdown.
+// It runs a program importing the package of the struct types, so that the
+// reference is built by reflection like validators.Markdown: the package must
+// not be a main package and its module must require the validators module.
+//
+// Usage:
+//
+//	go run github.com/orange-cloudavenue/common-go/validators/cmd/validatorsdoc -dir ./models -type EdgeGateway,Network -case bash -output docs/edgegateway.md
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// nameFuncs are the strcase functions converting the field names indexed by the -case flag.
+var nameFuncs = map[string]string{
+	"snake": "ToSnake",
+	"bash":  "ToBashArg",
+}
+
+// program is the template of the program rendering the reference of the struct types.
+var program = template.Must(template.New("program").Parse(`// Code generated by validatorsdoc. DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/orange-cloudavenue/common-go/strcase"
+	"github.com/orange-cloudavenue/common-go/validators"
+
+	pkg "{{ .ImportPath }}"
+)
+
+func main() {
+	structs, err := validators.NewStructDocs({{ range .Types }}(*pkg.{{ . }})(nil), {{ end }})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	fmt.Print(validators.RenderMarkdown(structs, validators.WithNameFunc(strcase.{{ .NameFunc }})))
+}
+`))
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the package of the struct types")
+	types := flag.String("type", "", "comma-separated list of the struct types to document")
+	nameCase := flag.String("case", "snake", "case of the field names: snake (Terraform) or bash (CLI flags)")
+	output := flag.String("output", "", "path of the generated file (default standard output)")
+	flag.Parse()
+
+	if *types == "" {
+		log.Fatal("-type is required")
+	}
+
+	md, err := Markdown(*dir, strings.Split(*types, ","), *nameCase)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *output == "" {
+		fmt.Print(md)
+		return
+	}
+	if err := os.WriteFile(*output, []byte(md), 0o600); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Markdown returns the Markdown reference of the struct types of the package
+// directory (see validators.RenderMarkdown) with the field names in nameCase.
+func Markdown(dir string, types []string, nameCase string) (string, error) {
+	nameFunc, ok := nameFuncs[nameCase]
+	if !ok {
+		return "", fmt.Errorf("unknown case %q", nameCase)
+	}
+	for i, t := range types {
+		types[i] = strings.TrimSpace(t)
+		if !token.IsIdentifier(types[i]) {
+			return "", fmt.Errorf("invalid type name %q", t)
+		}
+	}
+
+	importPath, err := goCommand(dir, "list", "-f", "{{.ImportPath}}", ".")
+	if err != nil {
+		return "", err
+	}
+
+	var b bytes.Buffer
+	if err := program.Execute(&b, map[string]any{
+		"ImportPath": strings.TrimSpace(importPath),
+		"Types":      types,
+		"NameFunc":   nameFunc,
+	}); err != nil {
+		return "", err
+	}
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return "", err
+	}
+
+	// The program is built in the module of the package.
+	tmp, err := os.MkdirTemp(dir, "validatorsdoc_")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmp)
+
+	if err := os.WriteFile(filepath.Join(tmp, "main.go"), src, 0o600); err != nil {
+		return "", err
+	}
+
+	return goCommand(tmp, "run", ".")
+}
+
+// goCommand runs the go command in the directory and returns its standard output.
+func goCommand(dir string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("go %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.String(), nil
+}
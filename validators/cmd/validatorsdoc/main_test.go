@@ -0,0 +1,75 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/orange-cloudavenue/common-go/strcase"
+	"github.com/orange-cloudavenue/common-go/validators"
+	models "github.com/orange-cloudavenue/common-go/validators/cmd/validatorsdoc/testdata"
+)
+
+func TestMarkdown(t *testing.T) {
+	for nameCase, nameFunc := range map[string]func(string) string{"snake": strcase.ToSnake, "bash": strcase.ToBashArg} {
+		got, err := Markdown("testdata", []string{"EdgeGateway"}, nameCase)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		// The command and validators.Markdown give the same reference.
+		want, err := validators.Markdown(models.EdgeGateway{}, validators.WithNameFunc(nameFunc))
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, want, got)
+		assert.Contains(t, got, "### EdgeGateway.Logging\n")
+	}
+}
+
+func TestMarkdown_SeveralTypes(t *testing.T) {
+	got, err := Markdown("testdata", []string{"Rule", " EdgeGateway"}, "snake")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 1, strings.Count(got, "### Rule\n"))
+	assert.True(t, strings.HasPrefix(got, "### Rule\n"))
+	assert.Contains(t, got, "| `vdc` | String | Required if `vdc_group` is not set |  |  |\n")
+	assert.Contains(t, got, "| `vdc_group` | String | Optional, only if `vdc` is set |  |  |\n")
+}
+
+func TestMarkdown_Errors(t *testing.T) {
+	_, err := Markdown("testdata", []string{"Protocol"}, "snake")
+	assert.ErrorContains(t, err, "expects a struct")
+
+	_, err = Markdown("testdata", []string{"Unknown"}, "snake")
+	assert.ErrorContains(t, err, "Unknown")
+
+	_, err = Markdown("testdata", []string{"EdgeGateway{}"}, "snake")
+	assert.ErrorContains(t, err, "invalid type name")
+
+	_, err = Markdown("testdata", []string{"EdgeGateway"}, "camel")
+	assert.ErrorContains(t, err, "unknown case")
+
+	_, err = Markdown(t.TempDir(), []string{"EdgeGateway"}, "snake")
+	assert.Error(t, err)
+}
@@ -0,0 +1,48 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package models
+
+import "github.com/orange-cloudavenue/common-go/urn"
+
+type Protocol string
+
+type Base struct {
+	ID urn.URN `validate:"required,urn=edgegateway"`
+}
+
+type EdgeGateway struct {
+	Base
+
+	Name     string        `validate:"required,resource_name=edgegateway"`
+	Owner    urn.Reference `validate:"urn_reference=vdc"`
+	Rules    []*Rule       `validate:"dive"`
+	VDC      string        `validate:"required_if_null=VDCGroup"`
+	VDCGroup string        `validate:"excluded_if_null=VDC"`
+	Logging  struct {
+		Enabled bool `default:"false"`
+	}
+	Ignored string `json:"-"`
+	private string
+}
+
+type Rule struct {
+	DestinationPort int      `validate:"required,tcp_udp_port"`
+	Protocol        Protocol `validate:"omitempty,oneof=tcp udp" default:"tcp"`
+	Parent          *Rule
+}
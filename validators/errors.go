@@ -0,0 +1,247 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package validators
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/orange-cloudavenue/common-go/strcase"
+)
+
+// FieldError is a validation failure of a field.
+type FieldError struct {
+	// Path is the path of the field built from the JSON names (e.g. network.ports[0]).
+	Path string
+	// Flag is the path of the field built from the command-line flag names of the
+	// JSON names (see strcase.ToBashArg), e.g. edge-gateway.vdc-id for edge_gateway.vdc_id.
+	Flag string
+	// Rule is the key of the failed validation rule (e.g. tcp_udp_port).
+	Rule string
+	// Param is the parameter of the rule, if any.
+	Param string
+	// Value is the value of the field.
+	Value any
+	// Missing are the referenced fields which are not set, for the rules
+	// depending on other fields (e.g. required_if_null).
+	Missing []string
+	// Group are the other fields of the group, for the group rules (e.g. exactly_one_of).
+	// For conflicts_with, they are the conflicting fields which are set.
+	Group []string
+	// Message is the human-readable message of the failure.
+	Message string
+
+	err validator.FieldError
+	// translators are the fieldTranslators of the Validator, by locale.
+	translators map[string]ut.Translator
+}
+
+// Error returns the message of the failure, prefixed by the path of the field
+// when the field is nested.
+func (e *FieldError) Error() string {
+	if !strings.Contains(e.Path, ".") {
+		return e.Message
+	}
+
+	return e.Path + ": " + e.Message
+}
+
+// Unwrap returns the underlying go-playground validator.FieldError.
+func (e *FieldError) Unwrap() error {
+	return e.err
+}
+
+// ValidationErrors is the error returned by Validator.Struct when fields are not valid.
+type ValidationErrors []*FieldError
+
+// Error returns the failures, one per line.
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, fe := range e {
+		msgs[i] = fe.Error()
+	}
+
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the failures.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, fe := range e {
+		errs[i] = fe
+	}
+
+	return errs
+}
+
+// As sets target to the underlying go-playground validator.ValidationErrors when
+// target is a *validator.ValidationErrors, so that the code written for the
+// go-playground errors keeps working.
+func (e ValidationErrors) As(target any) bool {
+	verrs, ok := target.(*validator.ValidationErrors)
+	if !ok {
+		return false
+	}
+
+	*verrs = make(validator.ValidationErrors, 0, len(e))
+	for _, fe := range e {
+		if fe.err != nil {
+			*verrs = append(*verrs, fe.err)
+		}
+	}
+
+	return true
+}
+
+// Translate returns the failures with the messages in the language of the translator
+// (see Validator.Translator).
+func (e ValidationErrors) Translate(trans ut.Translator) ValidationErrors {
+	translated := make(ValidationErrors, len(e))
+	for i, fe := range e {
+		t := *fe
+		ft, ok := fe.translators[trans.Locale()]
+		if !ok {
+			ft = trans
+		}
+		t.Message = message(fe.err, ft, fieldName(fe.Path), fe.Missing, fe.Group)
+		translated[i] = &t
+	}
+
+	return translated
+}
+
+// newValidationErrors converts the go-playground validator.ValidationErrors of the
+// validated struct with the fieldTranslator trans. The other errors are returned unchanged.
+func newValidationErrors(err error, s any, trans ut.Translator, translators map[string]ut.Translator) error {
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return err
+	}
+
+	errs := make(ValidationErrors, len(verrs))
+	for i, fe := range verrs {
+		var missing, group []string
+		if parent, ok := lookupParent(reflect.ValueOf(s), fe.StructNamespace()); ok {
+			switch {
+			case fe.Tag() == RequireIfNull.Key || fe.Tag() == ExcludeIfNull.Key:
+				nulls, _ := nullTargets(parent, fe.Param())
+				for _, target := range nulls {
+					missing = append(missing, jsonPath(parent.Type(), target))
+				}
+			case isGroupRule(fe.Tag()):
+				targets := groupTargets(parent.Type(), fe.StructField(), fe.Param())
+				if fe.Tag() == ConflictsWith.Key {
+					targets = setTargets(parent, targets)
+				}
+				for _, target := range targets {
+					group = append(group, jsonPath(parent.Type(), target))
+				}
+			}
+		}
+		errs[i] = newFieldError(fe, reflect.TypeOf(s), trans, missing, group)
+		errs[i].translators = translators
+	}
+
+	return errs
+}
+
+// newFieldError converts a go-playground validator.FieldError of a field of the
+// struct type t. The paths and the message use the JSON names of the fields.
+func newFieldError(fe validator.FieldError, t reflect.Type, trans ut.Translator, missing, group []string) *FieldError {
+	path, flag := namespacePaths(t, fe.StructNamespace())
+
+	return &FieldError{
+		Path:    path,
+		Flag:    flag,
+		Rule:    fe.Tag(),
+		Param:   fe.Param(),
+		Value:   fe.Value(),
+		Missing: missing,
+		Group:   group,
+		Message: message(fe, trans, fieldName(path), missing, group),
+		err:     fe,
+	}
+}
+
+// message returns the message of the failure in the language of the translator,
+// naming the field with name when trans is a fieldTranslator.
+func message(fe validator.FieldError, trans ut.Translator, name string, missing, group []string) string {
+	// Translate returns the raw error when the rule has no translation.
+	msg := fe.Translate(trans)
+	switch {
+	case len(missing) > 0:
+		msg = conditionalMessage(trans, fe, missing)
+	case len(group) > 0:
+		msg = groupMessage(trans, fe, group)
+	case msg == fe.Error():
+		msg = defaultMessage(trans, fe)
+	}
+
+	return strings.ReplaceAll(msg, fieldPlaceholder, name)
+}
+
+// namespacePaths returns the JSON and the flag paths of the field at the struct
+// namespace of a validation error (e.g. EdgeGateway.Rules[1].Port), from the type
+// of the validated struct. The fields which cannot be resolved keep their Go name.
+func namespacePaths(t reflect.Type, namespace string) (jsonPath, flagPath string) {
+	// The namespace starts with the name of the validated struct.
+	segments := strings.Split(namespace, ".")[1:]
+	jsonNames := make([]string, len(segments))
+	flagNames := make([]string, len(segments))
+
+	for i, segment := range segments {
+		name, indexes, _ := strings.Cut(segment, "[")
+		if indexes != "" {
+			indexes = "[" + indexes
+		}
+		jsonNames[i], flagNames[i] = name+indexes, strcase.ToBashArg(name)+indexes
+
+		if t == nil || indirect(t).Kind() != reflect.Struct {
+			t = nil
+			continue
+		}
+		f, ok := indirect(t).FieldByName(name)
+		if !ok {
+			t = nil
+			continue
+		}
+		jsonNames[i], flagNames[i] = jsonName(f)+indexes, strcase.ToBashArg(jsonName(f))+indexes
+
+		// The elements of the slices, arrays and maps (e.g. Rules[1] or Tags[key]).
+		t = f.Type
+		for range strings.Count(indexes, "[") {
+			if k := indirect(t).Kind(); k != reflect.Slice && k != reflect.Array && k != reflect.Map {
+				t = nil
+				break
+			}
+			t = indirect(t).Elem()
+		}
+	}
+
+	return strings.Join(jsonNames, "."), strings.Join(flagNames, ".")
+}
+
+// fieldName returns the name of the field at the end of the path (e.g. number for ports[1].number).
+func fieldName(path string) string {
+	return path[strings.LastIndex(path, ".")+1:]
+}
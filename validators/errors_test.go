@@ -0,0 +1,182 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package validators_test
+
+import (
+	"errors"
+	"testing"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/orange-cloudavenue/common-go/validators"
+)
+
+type port struct {
+	Number string `json:"number" validate:"tcp_udp_port"`
+}
+
+type firewall struct {
+	Name  string `json:"name" validate:"required"`
+	Range string `json:"ip_range" validate:"omitempty,ipv4_range"`
+	Ports []port `json:"ports" validate:"dive"`
+	Owner string `validate:"urn=vdc vdcGroup"`
+}
+
+func TestValidationErrors(t *testing.T) {
+	t.Parallel()
+	invalid := firewall{
+		Range: "192.168.0.100-192.168.0.1",
+		Ports: []port{{Number: "80"}, {Number: "65536"}},
+		Owner: "urn:vcloud:vm:4aeb40d8-038c-4e77-8181-a7054f583b12",
+	}
+
+	err := validators.New().Struct(&invalid)
+
+	var errs validators.ValidationErrors
+	if !assert.ErrorAs(t, err, &errs) || !assert.Len(t, errs, 4) {
+		return
+	}
+
+	assert.Equal(t, "name", errs[0].Path)
+	assert.Equal(t, "required", errs[0].Rule)
+	assert.Equal(t, "name is a required field", errs[0].Message)
+
+	assert.Equal(t, "ip_range", errs[1].Path)
+	assert.Equal(t, "ip-range", errs[1].Flag)
+	assert.Equal(t, "ipv4_range", errs[1].Rule)
+	assert.Equal(t, "192.168.0.100-192.168.0.1", errs[1].Value)
+	assert.Equal(t, "ip_range must be a valid IPv4 range (e.g. 192.168.0.1-192.168.0.100)", errs[1].Message)
+
+	assert.Equal(t, "ports[1].number", errs[2].Path)
+	assert.Equal(t, "ports[1].number", errs[2].Flag)
+	assert.Equal(t, "tcp_udp_port", errs[2].Rule)
+	assert.Equal(t, "ports[1].number: number must be a valid TCP or UDP port (1-65535)", errs[2].Error())
+
+	assert.Equal(t, "Owner", errs[3].Path)
+	assert.Equal(t, "vdc vdcGroup", errs[3].Param)
+	assert.Equal(t, "Owner must be a valid URN of type vdc or vdcGroup", errs[3].Error())
+
+	// The go-playground errors are still available, with the Go names of the fields.
+	var fe validator.FieldError
+	if assert.True(t, errors.As(err, &fe)) {
+		assert.Equal(t, "required", fe.Tag())
+	}
+	var verrs validator.ValidationErrors
+	if assert.True(t, errors.As(err, &verrs)) && assert.Len(t, verrs, 4) {
+		assert.Equal(t, "Name", verrs[0].Field())
+		assert.Equal(t, "firewall.Ports[1].Number", verrs[2].Namespace())
+	}
+}
+
+func TestValidationErrors_Flag(t *testing.T) {
+	t.Parallel()
+	type gateway struct {
+		VDCID string `json:"vdc_id" validate:"required"`
+	}
+	type network struct {
+		EdgeGateway *gateway `json:"edge_gateway" validate:"required"`
+		IPRanges    []string `json:"ip_ranges" validate:"dive,ipv4_range"`
+	}
+
+	var errs validators.ValidationErrors
+	err := validators.New().Struct(&network{EdgeGateway: &gateway{}, IPRanges: []string{"invalid"}})
+	if !assert.ErrorAs(t, err, &errs) || !assert.Len(t, errs, 2) {
+		return
+	}
+
+	assert.Equal(t, "edge_gateway.vdc_id", errs[0].Path)
+	assert.Equal(t, "edge-gateway.vdc-id", errs[0].Flag)
+	assert.Equal(t, "edge_gateway.vdc_id: vdc_id is a required field", errs[0].Error())
+	assert.Equal(t, "ip_ranges[0]", errs[1].Path)
+	assert.Equal(t, "ip-ranges[0]", errs[1].Flag)
+	assert.Equal(t, "ip_ranges[0] must be a valid IPv4 range (e.g. 192.168.0.1-192.168.0.100)", errs[1].Error())
+}
+
+func TestValidationErrors_Locale(t *testing.T) {
+	t.Parallel()
+	invalid := firewall{
+		Name:  "fw",
+		Ports: []port{{Number: "invalid"}},
+		Owner: "urn:vcloud:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12",
+	}
+
+	v := validators.New(validators.WithLocale("fr"))
+	var errs validators.ValidationErrors
+	if !assert.ErrorAs(t, v.Struct(&invalid), &errs) || !assert.Len(t, errs, 1) {
+		return
+	}
+	assert.Equal(t, "number doit être un port TCP ou UDP valide (1-65535)", errs[0].Message)
+
+	// The errors can be translated afterwards.
+	translated := errs.Translate(v.Translator("en"))
+	assert.Equal(t, "number must be a valid TCP or UDP port (1-65535)", translated[0].Message)
+	assert.Equal(t, errs[0].Path, translated[0].Path)
+
+	// The French messages of the go-playground validators are used.
+	invalid.Name = ""
+	assert.ErrorContains(t, v.Struct(&invalid), "name est un champ obligatoire")
+
+	// An unsupported locale falls back to English.
+	assert.ErrorContains(t, validators.New(validators.WithLocale("xx")).Struct(&invalid), "name is a required field")
+}
+
+func TestValidationErrors_RegisterTranslation(t *testing.T) {
+	t.Parallel()
+	type setting struct {
+		Value string `json:"value" validate:"oneof=Value Other"`
+	}
+
+	v := validators.New()
+	trans := v.Translator("en")
+	err := v.RegisterTranslation("oneof", trans, func(trans ut.Translator) error {
+		return trans.Add("oneof", "Value of {0} must be one of [{1}]", true)
+	}, func(trans ut.Translator, fe validator.FieldError) string {
+		msg, _ := trans.T("oneof", fe.Field(), fe.Param())
+		return msg
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var errs validators.ValidationErrors
+	if !assert.ErrorAs(t, v.Struct(&setting{Value: "invalid"}), &errs) || !assert.Len(t, errs, 1) {
+		return
+	}
+
+	// Only the name of the field is replaced, not the same text in the template or the parameter.
+	assert.Equal(t, "Value of value must be one of [Value Other]", errs[0].Message)
+	assert.Equal(t, "Value of value must be one of [Value Other]", errs.Translate(trans)[0].Message)
+
+	// The go-playground errors keep the Go name.
+	var verrs validator.ValidationErrors
+	if assert.ErrorAs(t, errs, &verrs) {
+		assert.Equal(t, "Value of Value must be one of [Value Other]", verrs[0].Translate(trans))
+	}
+}
+
+func TestValidationErrors_NotStruct(t *testing.T) {
+	t.Parallel()
+	err := validators.New().Struct(&[]string{})
+
+	var errs validators.ValidationErrors
+	assert.Error(t, err)
+	assert.False(t, errors.As(err, &errs))
+}
@@ -4,18 +4,18 @@ go 1.25.0
 
 require (
 	github.com/creasty/defaults v1.8.0
+	github.com/go-playground/locales v0.14.1
+	github.com/go-playground/universal-translator v0.18.1
 	github.com/go-playground/validator/v10 v10.30.3
-	github.com/orange-cloudavenue/common-go/regex v1.2.0
+	github.com/orange-cloudavenue/common-go/regex v1.3.0
 	github.com/orange-cloudavenue/common-go/strcase v1.0.0
-	github.com/orange-cloudavenue/common-go/urn v1.2.0
+	github.com/orange-cloudavenue/common-go/urn v1.4.0
 	github.com/stretchr/testify v1.10.0
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/gabriel-vasile/mimetype v1.4.13 // indirect
-	github.com/go-playground/locales v0.14.1 // indirect
-	github.com/go-playground/universal-translator v0.18.1 // indirect
 	github.com/leodido/go-urn v1.4.0 // indirect
 	github.com/niemeyer/pretty v0.0.0-20200227124842-a10e7caefd8e // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
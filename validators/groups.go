@@ -0,0 +1,201 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package validators
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// The group rules constrain the number of set fields in a group made of the
+// validated field and its target fields, like the ExactlyOneOf, AtLeastOneOf,
+// RequiredWith and ConflictsWith attributes of the Terraform schemas.
+// Param is the list of the target fields (separated by spaces), with the same
+// syntax and null semantics as RequireIfNull. The validated field can be part of
+// the list, so that the same list can be set on each field of the group.
+// The group rules can also be registered on a struct type with Validator.RegisterGroup.
+var (
+	// ExactlyOneOf is a validator that requires exactly one field of the group to be set.
+	// Usage: `validate:"exactly_one_of=target_field"`
+	// E.g. `validate:"exactly_one_of=VDCID VDCName"`
+	ExactlyOneOf = groupValidator("exactly_one_of")
+
+	// AtLeastOneOf is a validator that requires at least one field of the group to be set.
+	// Usage: `validate:"at_least_one_of=target_field"`
+	// E.g. `validate:"at_least_one_of=VDCID VDCName"`
+	AtLeastOneOf = groupValidator("at_least_one_of")
+
+	// AllOrNoneOf is a validator that requires either all the fields of the group
+	// to be set or none of them.
+	// Usage: `validate:"all_or_none_of=target_field"`
+	// E.g. `validate:"all_or_none_of=Username Password"`
+	AllOrNoneOf = groupValidator("all_or_none_of")
+
+	// ConflictsWith is a validator that excludes all the target fields when the field is set.
+	// Usage: `validate:"conflicts_with=target_field"`
+	// E.g. `validate:"conflicts_with=VDCGroupID"`
+	ConflictsWith = groupValidator("conflicts_with")
+)
+
+// groupRules return whether a group satisfies the rule, from whether the
+// validated field is set, the number of set target fields and the number of target fields.
+var groupRules = map[string]func(set bool, setTargets, targets int) bool{
+	"exactly_one_of": func(set bool, setTargets, _ int) bool {
+		if set {
+			return setTargets == 0
+		}
+		return setTargets == 1
+	},
+	"at_least_one_of": func(set bool, setTargets, _ int) bool {
+		return set || setTargets > 0
+	},
+	"all_or_none_of": func(set bool, setTargets, targets int) bool {
+		if set {
+			return setTargets == targets
+		}
+		return setTargets == 0
+	},
+	"conflicts_with": func(set bool, setTargets, _ int) bool {
+		return !set || setTargets == 0
+	},
+}
+
+// isGroupRule returns true if key is the key of a group rule (e.g. exactly_one_of).
+func isGroupRule(key string) bool {
+	_, ok := groupRules[key]
+	return ok
+}
+
+func groupValidator(key string) *CustomValidator {
+	return &CustomValidator{
+		Key:            key,
+		CallEvenIfNull: true,
+		Func: func(fl validator.FieldLevel) bool {
+			return validGroup(key, fl.Parent(), fl.StructFieldName(), !isNull(fieldValue(fl)), fl.Param())
+		},
+	}
+}
+
+// validGroup returns whether the group of the field of the parent struct and of
+// the target fields of the param satisfies the rule.
+// The group is not valid if a target field does not exist.
+func validGroup(key string, parent reflect.Value, field string, set bool, param string) bool {
+	targets := groupTargets(reflect.Indirect(parent).Type(), field, param)
+	nulls, ok := nullTargets(parent, strings.Join(targets, " "))
+
+	return ok && groupRules[key](set, len(targets)-len(nulls), len(targets))
+}
+
+// groupTargets returns the target fields of the param without the field itself.
+func groupTargets(t reflect.Type, field, param string) []string {
+	var targets []string
+	for _, target := range strings.Fields(param) {
+		if f, ok := lookupFieldType(t, target); ok && f.Name == field && !strings.Contains(target, ".") {
+			continue
+		}
+		targets = append(targets, target)
+	}
+
+	return targets
+}
+
+// setTargets returns the target fields of the param which are set in the parent struct.
+func setTargets(parent reflect.Value, targets []string) []string {
+	nulls, _ := nullTargets(parent, strings.Join(targets, " "))
+
+	var set []string
+	for _, target := range targets {
+		if !slices.Contains(nulls, target) {
+			set = append(set, target)
+		}
+	}
+
+	return set
+}
+
+// group is a group rule registered on a struct type with Validator.RegisterGroup.
+type group struct {
+	key string
+	// field is the Go name of the field on which the failure is reported.
+	field string
+	// targets are the other fields of the group.
+	targets []string
+}
+
+// RegisterGroup registers a group rule (ExactlyOneOf, AtLeastOneOf, AllOrNoneOf or
+// ConflictsWith) on the struct type of s, for the structs whose fields cannot be tagged.
+// The failures are reported on the first field, with the other fields as parameter,
+// as if the rule was set on the first field: for ConflictsWith, the first field
+// conflicts with the other fields.
+// The group rules are validated after the struct-level validation registered with
+// Validator.RegisterStructValidation on the same type, whatever the order of the registrations.
+// Like the go-playground registrations, RegisterGroup is not safe for concurrent use
+// with the validations and panics if the rule is not a group rule or a field does not exist.
+// E.g. v.RegisterGroup(Network{}, validators.ExactlyOneOf, "VDCID", "VDCGroupID")
+func (v *Validator) RegisterGroup(s any, rule *CustomValidator, fields ...string) {
+	t := indirect(reflect.TypeOf(s))
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("validators: RegisterGroup expects a struct, got %s", t))
+	}
+	if !isGroupRule(rule.Key) {
+		panic(fmt.Sprintf("validators: %s is not a group rule", rule.Key))
+	}
+	if len(fields) < 2 {
+		panic(fmt.Sprintf("validators: the %s group of %s needs at least 2 fields", rule.Key, t))
+	}
+
+	f, ok := lookupFieldType(t, fields[0])
+	if !ok || len(f.Index) != 1 {
+		panic(fmt.Sprintf("validators: unknown field %q in %s", fields[0], t))
+	}
+	for _, target := range fields[1:] {
+		if _, ok := lookupField(reflect.New(t), target); !ok {
+			panic(fmt.Sprintf("validators: unknown field %q in %s", target, t))
+		}
+	}
+
+	if v.groups == nil {
+		v.groups = make(map[reflect.Type][]group)
+	}
+	v.groups[t] = append(v.groups[t], group{key: rule.Key, field: f.Name, targets: fields[1:]})
+	v.registerStructLevel(t)
+}
+
+// validateGroups validates the group rules registered on the struct type.
+func (v *Validator) validateGroups(sl validator.StructLevel) {
+	parent := sl.Current()
+	for _, g := range v.groups[parent.Type()] {
+		f, _ := parent.Type().FieldByName(g.field)
+		value := parent.FieldByIndex(f.Index)
+		param := strings.Join(g.targets, " ")
+
+		if !validGroup(g.key, parent, g.field, !isNull(value), param) {
+			// The value of an unexported field cannot be reported.
+			var field any
+			if value.CanInterface() {
+				field = value.Interface()
+			}
+			sl.ReportError(field, f.Name, f.Name, g.key, param)
+		}
+	}
+}
@@ -0,0 +1,392 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package validators_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/orange-cloudavenue/common-go/validators"
+)
+
+type groupNetwork struct {
+	ID *string `json:"id"`
+}
+
+type groupEdgeGateway struct {
+	VDC      string        `json:"vdc,omitempty"`
+	VDCGroup string        `json:"vdc_group,omitempty"`
+	Username string        `json:"username,omitempty"`
+	Password *string       `json:"password,omitempty"`
+	Token    string        `json:"token,omitempty"`
+	Ports    []int         `json:"ports,omitempty"`
+	Network  *groupNetwork `json:"network,omitempty"`
+}
+
+func TestGroups(t *testing.T) {
+	t.Parallel()
+	empty := ""
+
+	tests := []struct {
+		name  string
+		input any
+		valid bool
+	}{
+		// * exactly_one_of
+		{
+			name: "exactly-one-of-none",
+			input: &struct {
+				VDC      string `validate:"exactly_one_of=VDCGroup"`
+				VDCGroup string
+			}{},
+			valid: false,
+		},
+		{
+			name: "exactly-one-of-one",
+			input: &struct {
+				EdgeGatewayID   string `validate:"exactly_one_of=edge_gateway_name"`
+				EdgeGatewayName string
+			}{EdgeGatewayName: "name"},
+			valid: true,
+		},
+		{
+			name: "exactly-one-of-two",
+			input: &struct {
+				VDC      string `validate:"exactly_one_of=VDCGroup"`
+				VDCGroup string
+			}{VDC: "vdc", VDCGroup: "group"},
+			valid: false,
+		},
+		{
+			name: "exactly-one-of-with-self",
+			input: &struct {
+				VDC      string `validate:"exactly_one_of=VDC VDCGroup"`
+				VDCGroup string `validate:"exactly_one_of=VDC VDCGroup"`
+			}{VDC: "vdc"},
+			valid: true,
+		},
+		{
+			name: "exactly-one-of-nil-pointer",
+			input: &struct {
+				Password *string `validate:"exactly_one_of=Token"`
+				Token    string
+			}{},
+			valid: false,
+		},
+		{
+			name: "exactly-one-of-pointer-to-empty",
+			input: &struct {
+				Password *string `validate:"exactly_one_of=Token"`
+				Token    string
+			}{Password: &empty},
+			valid: true,
+		},
+		// * at_least_one_of
+		{
+			name: "at-least-one-of-none",
+			input: &struct {
+				VDC      string `validate:"at_least_one_of=VDCGroup Ports"`
+				VDCGroup string
+				Ports    []int
+			}{Ports: []int{}},
+			valid: false,
+		},
+		{
+			name: "at-least-one-of-all",
+			input: &struct {
+				VDC      string `validate:"at_least_one_of=VDCGroup Ports"`
+				VDCGroup string
+				Ports    []int
+			}{VDC: "vdc", VDCGroup: "group", Ports: []int{80}},
+			valid: true,
+		},
+		{
+			name: "at-least-one-of-nested",
+			input: &struct {
+				VDC     string `validate:"at_least_one_of=Network.ID"`
+				Network *groupNetwork
+			}{Network: &groupNetwork{ID: &empty}},
+			valid: true,
+		},
+		// * all_or_none_of
+		{
+			name: "all-or-none-of-none",
+			input: &struct {
+				Username string `validate:"all_or_none_of=Password"`
+				Password string
+			}{},
+			valid: true,
+		},
+		{
+			name: "all-or-none-of-all",
+			input: &struct {
+				Username string `validate:"all_or_none_of=Password"`
+				Password string
+			}{Username: "user", Password: "secret"},
+			valid: true,
+		},
+		{
+			name: "all-or-none-of-target-only",
+			input: &struct {
+				Username string `validate:"all_or_none_of=Password"`
+				Password string
+			}{Password: "secret"},
+			valid: false,
+		},
+		{
+			name: "all-or-none-of-field-only",
+			input: &struct {
+				Username string `validate:"all_or_none_of=Password"`
+				Password string
+			}{Username: "user"},
+			valid: false,
+		},
+		// * conflicts_with
+		{
+			name: "conflicts-with-unset",
+			input: &struct {
+				Token    string `validate:"conflicts_with=Username Password"`
+				Username string
+				Password string
+			}{Username: "user", Password: "secret"},
+			valid: true,
+		},
+		{
+			name: "conflicts-with-no-conflict",
+			input: &struct {
+				Token    string `validate:"conflicts_with=Username Password"`
+				Username string
+				Password string
+			}{Token: "token"},
+			valid: true,
+		},
+		{
+			name: "conflicts-with-conflict",
+			input: &struct {
+				Token    string `validate:"conflicts_with=Username Password"`
+				Username string
+				Password string
+			}{Token: "token", Password: "secret"},
+			valid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := validators.New().Struct(tt.input)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGroups_Errors(t *testing.T) {
+	t.Parallel()
+	type data struct {
+		VDC      string `json:"vdc,omitempty" validate:"exactly_one_of=VDC VDCGroup"`
+		VDCGroup string `json:"vdc_group,omitempty"`
+		Username string `json:"username,omitempty"`
+		Password string `json:"password,omitempty"`
+		Token    string `json:"token,omitempty" validate:"conflicts_with=Username Password"`
+	}
+
+	tests := []struct {
+		name    string
+		locale  string
+		input   data
+		group   []string
+		message string
+	}{
+		{
+			name:    "exactly-one-of",
+			input:   data{},
+			group:   []string{"vdc_group"},
+			message: "exactly one of vdc, vdc_group must be set",
+		},
+		{
+			name:    "conflicts-with",
+			input:   data{VDC: "vdc", Token: "token", Password: "secret"},
+			group:   []string{"password"},
+			message: "token conflicts with password",
+		},
+		{
+			name:    "conflicts-with-fr",
+			locale:  "fr",
+			input:   data{VDC: "vdc", Token: "token", Username: "user", Password: "secret"},
+			group:   []string{"username", "password"},
+			message: "token est incompatible avec username, password",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var errs validators.ValidationErrors
+			if !assert.True(t, errors.As(validators.New(validators.WithLocale(tt.locale)).Struct(&tt.input), &errs)) ||
+				!assert.Len(t, errs, 1) {
+				return
+			}
+
+			assert.Equal(t, tt.group, errs[0].Group)
+			assert.Equal(t, tt.message, errs[0].Message)
+		})
+	}
+}
+
+func TestRegisterGroup(t *testing.T) {
+	t.Parallel()
+	v := validators.New()
+	v.RegisterGroup(groupEdgeGateway{}, validators.ExactlyOneOf, "VDC", "vdc_group")
+	v.RegisterGroup(&groupEdgeGateway{}, validators.AllOrNoneOf, "Username", "Password")
+	v.RegisterGroup(groupEdgeGateway{}, validators.ConflictsWith, "Token", "Username", "Password")
+	v.RegisterGroup(groupEdgeGateway{}, validators.AtLeastOneOf, "Ports", "Network.ID")
+
+	secret, id := "secret", "id"
+	tests := []struct {
+		name  string
+		input groupEdgeGateway
+		rules []string
+	}{
+		{
+			name:  "valid",
+			input: groupEdgeGateway{VDC: "vdc", Username: "user", Password: &secret, Ports: []int{80}},
+		},
+		{
+			name:  "valid-nested",
+			input: groupEdgeGateway{VDCGroup: "group", Token: "token", Network: &groupNetwork{ID: &id}},
+		},
+		{
+			name:  "invalid",
+			input: groupEdgeGateway{VDC: "vdc", VDCGroup: "group", Username: "user", Token: "token", Network: &groupNetwork{}},
+			rules: []string{"exactly_one_of", "all_or_none_of", "conflicts_with", "at_least_one_of"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := v.Struct(&tt.input)
+			if len(tt.rules) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			var errs validators.ValidationErrors
+			if !assert.True(t, errors.As(err, &errs)) {
+				return
+			}
+			var rules []string
+			for _, fe := range errs {
+				rules = append(rules, fe.Rule)
+			}
+			assert.Equal(t, tt.rules, rules)
+			assert.Equal(t, "vdc", errs[0].Path)
+			assert.Equal(t, []string{"vdc_group"}, errs[0].Group)
+		})
+	}
+}
+
+func TestRegisterGroup_UnexportedField(t *testing.T) {
+	t.Parallel()
+	type owner struct {
+		vdc      string
+		vdcGroup string
+	}
+
+	v := validators.New()
+	v.RegisterGroup(owner{}, validators.ExactlyOneOf, "vdc", "vdcGroup")
+
+	assert.NoError(t, v.Struct(&owner{vdc: "vdc"}))
+
+	var errs validators.ValidationErrors
+	if !assert.ErrorAs(t, v.Struct(&owner{vdc: "vdc", vdcGroup: "group"}), &errs) || !assert.Len(t, errs, 1) {
+		return
+	}
+	assert.Equal(t, "exactly_one_of", errs[0].Rule)
+	assert.Equal(t, "vdc", errs[0].Path)
+	assert.Nil(t, errs[0].Value)
+}
+
+func TestRegisterGroup_StructValidation(t *testing.T) {
+	t.Parallel()
+	// tokenLevel is a struct-level validation reporting the tokens.
+	tokenLevel := func(sl validator.StructLevel) {
+		if gw, ok := sl.Current().Interface().(groupEdgeGateway); ok && gw.Token != "" {
+			sl.ReportError(gw.Token, "Token", "Token", "no_token", "")
+		}
+	}
+
+	before := validators.New()
+	before.RegisterStructValidation(tokenLevel, groupEdgeGateway{})
+	before.RegisterGroup(groupEdgeGateway{}, validators.ExactlyOneOf, "VDC", "VDCGroup")
+
+	after := validators.New()
+	after.RegisterGroup(groupEdgeGateway{}, validators.ExactlyOneOf, "VDC", "VDCGroup")
+	after.RegisterStructValidation(tokenLevel, &groupEdgeGateway{})
+
+	// The struct-level validation and the group rules are chained whatever the order.
+	for name, v := range map[string]*validators.Validator{"before": before, "after": after} {
+		var errs validators.ValidationErrors
+		if assert.ErrorAs(t, v.Struct(&groupEdgeGateway{Token: "token"}), &errs, name) && assert.Len(t, errs, 2, name) {
+			assert.Equal(t, "no_token", errs[0].Rule, name)
+			assert.Equal(t, "exactly_one_of", errs[1].Rule, name)
+		}
+	}
+
+	// A validation registered directly on the go-playground Validate replaces the group rules.
+	direct := validators.New()
+	direct.RegisterGroup(groupEdgeGateway{}, validators.ExactlyOneOf, "VDC", "VDCGroup")
+	direct.Validate.RegisterStructValidation(tokenLevel, groupEdgeGateway{})
+	var errs validators.ValidationErrors
+	if assert.ErrorAs(t, direct.Struct(&groupEdgeGateway{Token: "token"}), &errs) && assert.Len(t, errs, 1) {
+		assert.Equal(t, "no_token", errs[0].Rule)
+	}
+}
+
+func TestRegisterGroup_Panics(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		s      any
+		rule   *validators.CustomValidator
+		fields []string
+	}{
+		{name: "not-a-struct", s: "string", rule: validators.ExactlyOneOf, fields: []string{"A", "B"}},
+		{name: "not-a-group-rule", s: groupEdgeGateway{}, rule: validators.RequireIfNull, fields: []string{"VDC", "VDCGroup"}},
+		{name: "one-field", s: groupEdgeGateway{}, rule: validators.ExactlyOneOf, fields: []string{"VDC"}},
+		{name: "unknown-field", s: groupEdgeGateway{}, rule: validators.ExactlyOneOf, fields: []string{"VDC", "Unknown"}},
+		{name: "nested-first-field", s: groupEdgeGateway{}, rule: validators.ExactlyOneOf, fields: []string{"Network.ID", "VDC"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Panics(t, func() {
+				validators.New().RegisterGroup(tt.s, tt.rule, tt.fields...)
+			})
+		})
+	}
+}
@@ -0,0 +1,501 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package validators
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/orange-cloudavenue/common-go/regex"
+	"github.com/orange-cloudavenue/common-go/strcase"
+	"github.com/orange-cloudavenue/common-go/urn"
+)
+
+// StructDoc describes a struct documented by Markdown.
+type StructDoc struct {
+	// Name is the name of the struct type. An anonymous struct is named after the
+	// struct and the field declaring it (e.g. Firewall.Rules).
+	Name   string
+	Fields []FieldDoc
+}
+
+// FieldDoc describes a field of a StructDoc.
+type FieldDoc struct {
+	// Name is the Go name of the field.
+	Name string
+	// Type is the documented type of the field: String, Number, Boolean, Object,
+	// Any, "List of <type>" or "Map of <type>".
+	Type string
+	// Tag is the tag of the field (validate, default and description keys).
+	Tag reflect.StructTag
+	// Struct is the documented struct of an Object field (or of the items of a list or a map).
+	Struct *StructDoc
+}
+
+// MarkdownOption is an option of Markdown.
+type MarkdownOption func(*markdownOptions)
+
+type markdownOptions struct {
+	nameFunc func(string) string
+}
+
+// WithNameFunc sets the conversion of the Go names of the fields to the documented names.
+// The default is strcase.ToSnake (Terraform attributes); use strcase.ToBashArg for CLI flags.
+func WithNameFunc(nameFunc func(string) string) MarkdownOption {
+	return func(o *markdownOptions) {
+		o.nameFunc = nameFunc
+	}
+}
+
+// Markdown returns the Markdown reference of the struct type of v (a struct or
+// a pointer to a struct): a table per struct with the name, the type, whether the
+// field is required, the default value and the description of the rules of each field.
+func Markdown(v any, opts ...MarkdownOption) (string, error) {
+	s, err := NewStructDoc(v)
+	if err != nil {
+		return "", err
+	}
+
+	return s.Markdown(opts...), nil
+}
+
+// NewStructDoc returns the StructDoc of the struct type of v (a struct or a pointer to a struct).
+func NewStructDoc(v any) (*StructDoc, error) {
+	structs, err := NewStructDocs(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return structs[0], nil
+}
+
+// NewStructDocs returns the StructDocs of the struct types of the values (structs or
+// pointers to structs). The structs nested in several types share their StructDoc,
+// so that RenderMarkdown renders them once.
+func NewStructDocs(values ...any) ([]*StructDoc, error) {
+	known := make(map[reflect.Type]*StructDoc)
+	structs := make([]*StructDoc, len(values))
+	for i, v := range values {
+		t := reflect.TypeOf(v)
+		for t != nil && t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t == nil || t.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("validators: NewStructDoc() expects a struct, got %v", t)
+		}
+
+		structs[i] = structDoc(t, t.Name(), known)
+	}
+
+	return structs, nil
+}
+
+// structDoc returns the StructDoc of the struct type named name. The known types
+// are not documented twice, which also stops the recursive types.
+func structDoc(t reflect.Type, name string, known map[reflect.Type]*StructDoc) *StructDoc {
+	if s, ok := known[t]; ok {
+		return s
+	}
+
+	s := &StructDoc{Name: name}
+	known[t] = s
+
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name == "-" {
+			continue
+		}
+
+		// The fields of the embedded structs are promoted.
+		if ft := indirect(f.Type); f.Anonymous && ft.Kind() == reflect.Struct && f.Tag.Get("json") == "" {
+			s.Fields = append(s.Fields, structDoc(ft, ft.Name(), known).Fields...)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+
+		fd := FieldDoc{Name: f.Name, Tag: f.Tag}
+		fd.Type, fd.Struct = typeDoc(f.Type, s.Name+"."+f.Name, known)
+		s.Fields = append(s.Fields, fd)
+	}
+
+	return s
+}
+
+// typeDoc returns the documented type of t and its StructDoc if t is an Object.
+// An anonymous struct is named name.
+func typeDoc(t reflect.Type, name string, known map[reflect.Type]*StructDoc) (string, *StructDoc) {
+	t = indirect(t)
+
+	switch {
+	case t == timeType:
+		return "String", nil
+	case t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType):
+		return "String", nil
+	}
+
+	switch t.Kind() { //nolint:exhaustive // the other kinds are not documented
+	case reflect.Bool:
+		return "Boolean", nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "Number", nil
+	case reflect.String:
+		return "String", nil
+	case reflect.Slice, reflect.Array:
+		elem, s := typeDoc(t.Elem(), name, known)
+		return "List of " + elem, s
+	case reflect.Map:
+		elem, s := typeDoc(t.Elem(), name, known)
+		return "Map of " + elem, s
+	case reflect.Struct:
+		if t.Name() != "" {
+			name = t.Name()
+		}
+		return "Object", structDoc(t, name, known)
+	default:
+		return "Any", nil
+	}
+}
+
+// Markdown returns the Markdown reference of the struct followed by the
+// references of its nested structs (see Markdown).
+func (s *StructDoc) Markdown(opts ...MarkdownOption) string {
+	return RenderMarkdown([]*StructDoc{s}, opts...)
+}
+
+// RenderMarkdown returns the Markdown references of the structs followed by the
+// references of their nested structs. Each struct is rendered once.
+func RenderMarkdown(structs []*StructDoc, opts ...MarkdownOption) string {
+	o := markdownOptions{nameFunc: strcase.ToSnake}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var (
+		b    strings.Builder
+		done = make(map[*StructDoc]bool)
+	)
+	queue := slices.Clone(structs)
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if done[current] {
+			continue
+		}
+		done[current] = true
+
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "### %s\n\n", current.Name)
+		b.WriteString("| Name | Type | Required | Default | Description |\n")
+		b.WriteString("|------|------|----------|---------|-------------|\n")
+
+		for _, f := range current.Fields {
+			typ := f.Type
+			if f.Struct != nil {
+				// Link the Object to the table of the nested struct.
+				typ = strings.TrimSuffix(typ, "Object") + fmt.Sprintf("[%s](#%s)", f.Struct.Name, anchor(f.Struct.Name))
+				queue = append(queue, f.Struct)
+			}
+
+			def := ""
+			if v, ok := f.Tag.Lookup("default"); ok {
+				def = "`" + v + "`"
+			}
+
+			fmt.Fprintf(&b, "| `%s` | %s | %s | %s | %s |\n",
+				o.nameFunc(f.Name), typ, f.required(o.nameFunc), escapeCell(def), escapeCell(f.description()))
+		}
+	}
+
+	return b.String()
+}
+
+// anchor returns the anchor of the Markdown heading (e.g. firewallrules for Firewall.Rules).
+func anchor(heading string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(heading) {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r), r == '-', r == '_':
+			b.WriteRune(r)
+		case r == ' ':
+			b.WriteRune('-')
+		}
+	}
+
+	return b.String()
+}
+
+// required returns whether the field is required, from the required,
+// required_if_null, excluded_if_null and group rules.
+func (f FieldDoc) required(nameFunc func(string) string) string {
+	targets := func(param string) []string {
+		names := strings.Fields(param)
+		for i, name := range names {
+			segments := strings.Split(name, ".")
+			for j, segment := range segments {
+				segments[j] = nameFunc(strcase.ToPublicGoName(segment))
+			}
+			names[i] = "`" + strings.Join(segments, ".") + "`"
+		}
+		return names
+	}
+
+	for rule := range strings.SplitSeq(f.Tag.Get("validate"), ",") {
+		key, param, _ := strings.Cut(rule, "=")
+		switch key {
+		case "dive":
+			return "Optional"
+		case "required":
+			return "Required"
+		case RequireIfNull.Key:
+			return "Required if " + strings.Join(targets(param), " or ") + " is not set"
+		case ExcludeIfNull.Key:
+			return "Optional, only if " + strings.Join(targets(param), " and ") + " is set"
+		case ExactlyOneOf.Key:
+			return "Exactly one of " + strings.Join(f.group(param, targets), ", ")
+		case AtLeastOneOf.Key:
+			return "At least one of " + strings.Join(f.group(param, targets), ", ")
+		case AllOrNoneOf.Key:
+			return "Optional, all or none of " + strings.Join(f.group(param, targets), ", ")
+		case ConflictsWith.Key:
+			return "Optional, conflicts with " + strings.Join(targets(strings.Join(f.groupTargets(param), " ")), ", ")
+		}
+	}
+
+	return "Optional"
+}
+
+// group returns the documented names of the fields of the group of a group
+// rule: the field itself followed by its targets.
+func (f FieldDoc) group(param string, targets func(string) []string) []string {
+	names := targets(f.Name)
+	return append(names, targets(strings.Join(f.groupTargets(param), " "))...)
+}
+
+// groupTargets returns the targets of a group rule without the field itself.
+func (f FieldDoc) groupTargets(param string) []string {
+	var names []string
+	for _, name := range strings.Fields(param) {
+		if name != f.Name && strcase.ToPublicGoName(name) != f.Name {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// description returns the description tag of the field followed by the
+// descriptions of its rules.
+func (f FieldDoc) description() string {
+	var sentences []string
+	if d := f.Tag.Get("description"); d != "" {
+		sentences = append(sentences, strings.TrimSuffix(d, ".")+".")
+	}
+
+	typ, prefix := f.Type, ""
+	for rule := range strings.SplitSeq(f.Tag.Get("validate"), ",") {
+		if rule == "dive" {
+			// The next rules apply to the items.
+			typ = strings.TrimPrefix(strings.TrimPrefix(typ, "List of "), "Map of ")
+			prefix = "Each item "
+			continue
+		}
+
+		var alternatives []string
+		for alt := range strings.SplitSeq(rule, "|") {
+			if sentence := ruleDoc(typ, alt); sentence != "" {
+				alternatives = append(alternatives, sentence)
+			}
+		}
+		if len(alternatives) == 0 {
+			continue
+		}
+
+		sentence := strings.Join(alternatives, " or ")
+		if prefix != "" {
+			sentence = prefix + lowerFirst(sentence)
+		}
+		sentences = append(sentences, upperFirst(sentence)+".")
+	}
+
+	return strings.Join(sentences, " ")
+}
+
+// ruleFormats are the descriptions of the go-playground format rules.
+var ruleFormats = map[string]string{
+	"email":            "must be a valid email address",
+	"url":              "must be a valid URL",
+	"http_url":         "must be a valid HTTP URL",
+	"uri":              "must be a valid URI",
+	"uuid":             "must be a valid UUID",
+	"uuid4":            "must be a valid UUID",
+	"uuid_rfc4122":     "must be a valid UUID",
+	"ip":               "must be a valid IP address",
+	"ipv4":             "must be a valid IPv4 address",
+	"ipv6":             "must be a valid IPv6 address",
+	"cidr":             "must be a valid CIDR",
+	"cidrv4":           "must be a valid IPv4 CIDR",
+	"cidrv6":           "must be a valid IPv6 CIDR",
+	"hostname":         "must be a valid hostname",
+	"hostname_rfc1123": "must be a valid hostname",
+	"fqdn":             "must be a valid FQDN",
+	"unique":           "must contain unique items",
+}
+
+// ruleDoc returns the description of the rule (key=param) of a field of the
+// documented type typ, without final period. It returns an empty string for the
+// rules that do not constrain the value (e.g. omitempty) or are not documented.
+func ruleDoc(typ, rule string) string {
+	key, param, _ := strings.Cut(rule, "=")
+	param = strings.NewReplacer("0x2C", ",", "0x7C", "|").Replace(param)
+
+	if d, ok := ruleFormats[key]; ok {
+		return d
+	}
+
+	switch key {
+	case "", "omitempty", "omitnil", "required", RequireIfNull.Key, ExcludeIfNull.Key,
+		ExactlyOneOf.Key, AtLeastOneOf.Key, AllOrNoneOf.Key, ConflictsWith.Key:
+		return ""
+	case "oneof":
+		values := strings.Fields(param)
+		for i, v := range values {
+			values[i] = "`" + strings.Trim(v, "'") + "`"
+		}
+		return "must be one of " + strings.Join(values, ", ")
+	case "min", "max", "len", "gt", "gte", "lt", "lte":
+		return boundDoc(typ, key, param)
+	case URN.Key:
+		p, ok := parseURNParam(param)
+		if !ok {
+			return ""
+		}
+		d := "must be a valid " + urnTypesDoc(p.types) + "URN"
+		if p.bareUUID {
+			d += " or UUID"
+		}
+		return d
+	case URNReference.Key:
+		p := urnParam{}
+		if param != "" {
+			var ok bool
+			if p, ok = parseURNParam(param); !ok {
+				return ""
+			}
+		}
+		d := "must reference a valid " + urnTypesDoc(p.types) + "URN"
+		if p.bareUUID {
+			d += " or UUID"
+		}
+		return d
+	case CAVResourceName.Key:
+		for _, resource := range regex.ListCavResourceNames {
+			if resource.Key != param {
+				continue
+			}
+			// The description is "<name> (<example>)".
+			if name, example, ok := strings.Cut(resource.Description, " ("); ok {
+				return fmt.Sprintf("must be a valid %s like %s", name, strings.TrimSuffix(example, ")"))
+			}
+			return "must be a valid " + resource.Description
+		}
+		return ""
+	}
+
+	// The other custom rules share the messages of the errors.
+	msg, ok := messages[DefaultLocale][key]
+	if !ok {
+		return ""
+	}
+
+	return strings.TrimPrefix(strings.ReplaceAll(msg, "{1}", param), "{0} ")
+}
+
+// boundDoc returns the description of a min, max, len, gt, gte, lt or lte rule.
+func boundDoc(typ, key, param string) string {
+	unit := ""
+	switch {
+	case typ == "String":
+		unit = " characters"
+	case strings.HasPrefix(typ, "List of ") || strings.HasPrefix(typ, "Map of "):
+		unit = " items"
+	}
+
+	if unit == "" {
+		op := map[string]string{
+			"min": "greater than or equal to", "gte": "greater than or equal to", "gt": "greater than",
+			"max": "less than or equal to", "lte": "less than or equal to", "lt": "less than", "len": "equal to",
+		}[key]
+		return fmt.Sprintf("must be %s %s", op, param)
+	}
+
+	op := map[string]string{
+		"min": "at least", "gte": "at least", "gt": "more than",
+		"max": "at most", "lte": "at most", "lt": "less than", "len": "exactly",
+	}[key]
+	return fmt.Sprintf("must contain %s %s%s", op, param, unit)
+}
+
+// urnTypesDoc returns the display names of the URN types followed by a space
+// (e.g. "VDC or VDC Group "), or an empty string for any type.
+func urnTypesDoc(types []urn.URN) string {
+	if len(types) == 0 {
+		return ""
+	}
+
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.String()
+		if info, ok := urn.Info(t); ok {
+			names[i] = info.DisplayName
+		}
+	}
+
+	return strings.Join(names, " or ") + " "
+}
+
+// escapeCell escapes the pipes of a Markdown table cell.
+func escapeCell(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[size:]
+}
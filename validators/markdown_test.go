@@ -0,0 +1,150 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/orange-cloudavenue/common-go/strcase"
+	"github.com/orange-cloudavenue/common-go/urn"
+	"github.com/orange-cloudavenue/common-go/validators"
+)
+
+type docsRule struct {
+	DestinationPort string   `validate:"required,tcp_udp_port"`
+	Protocol        string   `validate:"omitempty,oneof=tcp udp" default:"tcp"`
+	Sources         []string `validate:"omitempty,max=10,dive,ipv4|ipv6"`
+}
+
+type docsEdgeGateway struct {
+	Name     string        `validate:"required,resource_name=edgegateway" description:"The name of the edge gateway"`
+	OwnerID  string        `validate:"urn=vdc vdcGroup"`
+	Owner    urn.Reference `validate:"urn_reference=vdc"`
+	Label    string        `validate:"case=snake_case,min=2"`
+	Rules    []docsRule    `validate:"dive"`
+	VDC      string        `validate:"required_if_null=VDCGroup"`
+	VDCGroup string        `validate:"excluded_if_null=VDC"`
+	Retries  int           `validate:"lte=5" default:"3"`
+	Ignored  string        `json:"-"`
+}
+
+func TestMarkdown(t *testing.T) {
+	t.Parallel()
+	got, err := validators.Markdown(&docsEdgeGateway{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	want := "### docsEdgeGateway\n\n" +
+		"| Name | Type | Required | Default | Description |\n" +
+		"|------|------|----------|---------|-------------|\n" +
+		"| `name` | String | Required |  | The name of the edge gateway. Must be a valid Edge Gateway name like tn01e02ocb0001234spt101. |\n" +
+		"| `owner_id` | String | Optional |  | Must be a valid VDC or VDC Group URN. |\n" +
+		"| `owner` | [Reference](#reference) | Optional |  | Must reference a valid VDC URN. |\n" +
+		"| `label` | String | Optional |  | Must be in snake_case. Must contain at least 2 characters. |\n" +
+		"| `rules` | List of [docsRule](#docsrule) | Optional |  |  |\n" +
+		"| `vdc` | String | Required if `vdc_group` is not set |  |  |\n" +
+		"| `vdc_group` | String | Optional, only if `vdc` is set |  |  |\n" +
+		"| `retries` | Number | Optional | `3` | Must be less than or equal to 5. |\n" +
+		"\n### Reference\n\n" +
+		"| Name | Type | Required | Default | Description |\n" +
+		"|------|------|----------|---------|-------------|\n" +
+		"| `name` | String | Optional |  |  |\n" +
+		"| `id` | String | Optional |  |  |\n" +
+		"\n### docsRule\n\n" +
+		"| Name | Type | Required | Default | Description |\n" +
+		"|------|------|----------|---------|-------------|\n" +
+		"| `destination_port` | String | Required |  | Must be a valid TCP or UDP port (1-65535). |\n" +
+		"| `protocol` | String | Optional | `tcp` | Must be one of `tcp`, `udp`. |\n" +
+		"| `sources` | List of String | Optional |  | Must contain at most 10 items. Each item must be a valid IPv4 address or must be a valid IPv6 address. |\n"
+	assert.Equal(t, want, got)
+}
+
+func TestMarkdown_Groups(t *testing.T) {
+	t.Parallel()
+	type group struct {
+		EdgeGatewayID   string `validate:"exactly_one_of=EdgeGatewayID EdgeGatewayName"`
+		EdgeGatewayName string `validate:"exactly_one_of=edge_gateway_id edge_gateway_name"`
+		Username        string `validate:"all_or_none_of=Password"`
+		Password        string `validate:"at_least_one_of=Token"`
+		Token           string `validate:"conflicts_with=Username Password"`
+	}
+
+	got, err := validators.Markdown(group{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Contains(t, got, "| `edge_gateway_id` | String | Exactly one of `edge_gateway_id`, `edge_gateway_name` |")
+	assert.Contains(t, got, "| `edge_gateway_name` | String | Exactly one of `edge_gateway_name`, `edge_gateway_id` |")
+	assert.Contains(t, got, "| `username` | String | Optional, all or none of `username`, `password` |")
+	assert.Contains(t, got, "| `password` | String | At least one of `password`, `token` |")
+	assert.Contains(t, got, "| `token` | String | Optional, conflicts with `username`, `password` |")
+}
+
+func TestMarkdown_BashArgs(t *testing.T) {
+	t.Parallel()
+	got, err := validators.Markdown(docsRule{}, validators.WithNameFunc(strcase.ToBashArg))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Contains(t, got, "| `destination-port` | String | Required |")
+	assert.Contains(t, got, "| `sources` | List of String | Optional |")
+}
+
+func TestMarkdown_AnonymousStruct(t *testing.T) {
+	t.Parallel()
+	type firewall struct {
+		Rules []struct {
+			Port string `validate:"required,tcp_udp_port"`
+		} `validate:"dive"`
+		Logging *struct {
+			Enabled bool `default:"false"`
+		}
+	}
+
+	got, err := validators.Markdown(firewall{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Contains(t, got, "| `rules` | List of [firewall.Rules](#firewallrules) | Optional |  |  |\n")
+	assert.Contains(t, got, "| `logging` | [firewall.Logging](#firewalllogging) | Optional |  |  |\n")
+	assert.Contains(t, got, "\n### firewall.Rules\n\n")
+	assert.Contains(t, got, "| `port` | String | Required |  | Must be a valid TCP or UDP port (1-65535). |\n")
+	assert.Contains(t, got, "\n### firewall.Logging\n\n")
+	assert.NotContains(t, got, "### \n")
+}
+
+func TestMarkdown_Errors(t *testing.T) {
+	t.Parallel()
+	_, err := validators.Markdown("not a struct")
+	assert.Error(t, err)
+
+	// The recursive types are documented once.
+	type node struct {
+		Children []node `validate:"dive"`
+	}
+	got, err := validators.Markdown(node{})
+	assert.NoError(t, err)
+	assert.Contains(t, got, "| `children` | List of [node](#node) | Optional |  |  |\n")
+}
@@ -26,5 +26,7 @@ type (
 	CustomValidator struct {
 		Key  string
 		Func validator.Func
+		// CallEvenIfNull calls Func for the nil pointers instead of failing.
+		CallEvenIfNull bool
 	}
 )
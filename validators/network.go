@@ -19,8 +19,8 @@
 package validators
 
 import (
-	"bytes"
-	"net"
+	"fmt"
+	"net/netip"
 	"reflect"
 	"strconv"
 	"strings"
@@ -33,30 +33,267 @@ var IPV4Range = &CustomValidator{
 	Key: "ipv4_range",
 	Func: func(fl validator.FieldLevel) bool {
 		// ipv4_range is a string in the form of "192.168.0.1-192.168.0.100"
-		// We need to split the string into two parts and validate each part
-		// as a valid IPv4 address.
+		r, ok := parseIPRange(fl.Field())
+		return ok && r.from.Is4()
+	},
+}
+
+// IPV6Range is a custom validator that checks if a string is a valid IPv6 range.
+// E.g. `validate:"ipv6_range"` for "2001:db8::1-2001:db8::ff".
+var IPV6Range = &CustomValidator{
+	Key: "ipv6_range",
+	Func: func(fl validator.FieldLevel) bool {
+		r, ok := parseIPRange(fl.Field())
+		return ok && r.from.Is6()
+	},
+}
+
+// IPRange is a custom validator that checks if a string is a valid IPv4 or IPv6 range.
+// Both addresses of the range are of the same family.
+// E.g. `validate:"ip_range"` for "192.168.0.1-192.168.0.100" or "2001:db8::1-2001:db8::ff".
+var IPRange = &CustomValidator{
+	Key: "ip_range",
+	Func: func(fl validator.FieldLevel) bool {
+		_, ok := parseIPRange(fl.Field())
+		return ok
+	},
+}
 
-		// Split the string into two parts
-		parts := strings.Split(fl.Field().String(), "-")
-		if len(parts) != 2 {
+// CIDRPrefix is a custom validator that checks if a string is a valid IPv4 or IPv6 CIDR
+// whose prefix length is within bounds.
+// Param is the prefix length (24) or the range of the prefix lengths (16-28).
+// E.g. `validate:"cidr_prefix=16-28"` for "192.168.0.0/24".
+var CIDRPrefix = &CustomValidator{
+	Key: "cidr_prefix",
+	Func: func(fl validator.FieldLevel) bool {
+		minBits, maxBits, ok := parsePrefixBounds(fl.Param())
+		if !ok {
 			return false
 		}
 
-		// Check if the first IP address is less than the second IP address
-		firstIP := net.ParseIP(parts[0])
-		secondIP := net.ParseIP(parts[1])
-		if firstIP.To4() == nil || secondIP.To4() == nil {
-			return false
+		p, ok := parsePrefix(fl.Field())
+		return ok && p.Bits() >= minBits && p.Bits() <= maxBits
+	},
+}
+
+// IPInCIDR is a custom validator that checks if an IP address is within the CIDR
+// of another field (e.g. a gateway within its subnet).
+// Param is the name of the CIDR field, with the same syntax as RequireIfNull.
+// The rule is satisfied if the CIDR field is null: use required on the CIDR field.
+// The rule fails if the CIDR field does not exist.
+// E.g. `validate:"ip_in_cidr=Subnet"`
+var IPInCIDR = &CustomValidator{
+	Key: "ip_in_cidr",
+	Func: func(fl validator.FieldLevel) bool {
+		p, ok, null := cidrField(fl)
+		if null {
+			return true
 		}
 
-		if bytes.Compare(firstIP.To4(), secondIP.To4()) >= 0 {
+		addr, err := parseAddr(fl.Field())
+		return ok && err == nil && p.Contains(addr)
+	},
+}
+
+// RangeInCIDR is a custom validator that checks if an IP range is within the CIDR
+// of another field (e.g. a DHCP pool within its subnet).
+// Param is the name of the CIDR field, with the same syntax as RequireIfNull.
+// The rule is satisfied if the CIDR field is null: use required on the CIDR field.
+// The rule fails if the CIDR field does not exist.
+// E.g. `validate:"range_in_cidr=Subnet"`
+var RangeInCIDR = &CustomValidator{
+	Key: "range_in_cidr",
+	Func: func(fl validator.FieldLevel) bool {
+		p, ok, null := cidrField(fl)
+		if null {
+			return true
+		}
+
+		r, rangeOK := parseIPRange(fl.Field())
+		return ok && rangeOK && p.Contains(r.from) && p.Contains(r.to)
+	},
+}
+
+// PrivateIP is a custom validator that checks if a string is a private IPv4 (RFC 1918)
+// or IPv6 (RFC 4193) address.
+// E.g. `validate:"private_ip"` for "192.168.0.1".
+var PrivateIP = &CustomValidator{
+	Key: "private_ip",
+	Func: func(fl validator.FieldLevel) bool {
+		addr, err := parseAddr(fl.Field())
+		return err == nil && addr.IsPrivate()
+	},
+}
+
+// NoOverlap is a custom validator that checks if a list of CIDRs and IP ranges
+// does not contain overlapping items. An item which is not a CIDR nor a range is not valid.
+// E.g. `validate:"no_overlap"` for []string{"192.168.0.0/24", "192.168.1.1-192.168.1.100"}.
+var NoOverlap = &CustomValidator{
+	Key: "no_overlap",
+	Func: func(fl validator.FieldLevel) bool {
+		field := fl.Field()
+		if field.Kind() != reflect.Slice && field.Kind() != reflect.Array {
 			return false
 		}
 
+		ranges := make([]ipRange, 0, field.Len())
+		for i := range field.Len() {
+			r, ok := parseIPRange(field.Index(i))
+			if !ok {
+				p, prefixOK := parsePrefix(field.Index(i))
+				if !prefixOK {
+					return false
+				}
+				r = prefixRange(p)
+			}
+
+			for _, other := range ranges {
+				if r.overlaps(other) {
+					return false
+				}
+			}
+			ranges = append(ranges, r)
+		}
+
 		return true
 	},
 }
 
+// ipRange is a range of IP addresses of the same family, bounds included.
+type ipRange struct {
+	from, to netip.Addr
+}
+
+func (r ipRange) overlaps(o ipRange) bool {
+	return r.from.BitLen() == o.from.BitLen() && r.from.Compare(o.to) <= 0 && o.from.Compare(r.to) <= 0
+}
+
+// stringValue returns the string of a string or of a fmt.Stringer (e.g. netip.Prefix).
+func stringValue(v reflect.Value) (string, bool) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", false
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() == reflect.String {
+		return v.String(), true
+	}
+	if v.IsValid() && v.CanInterface() {
+		if s, ok := v.Interface().(fmt.Stringer); ok {
+			return s.String(), true
+		}
+	}
+
+	return "", false
+}
+
+// parseAddr parses the IP address of the value, without zone.
+func parseAddr(v reflect.Value) (netip.Addr, error) {
+	s, ok := stringValue(v)
+	if !ok {
+		return netip.Addr{}, fmt.Errorf("validators: %s is not a string", v.Type())
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err == nil && addr.Zone() != "" {
+		return netip.Addr{}, fmt.Errorf("validators: %s has a zone", s)
+	}
+
+	return addr, err
+}
+
+// parsePrefix parses the CIDR of the value.
+func parsePrefix(v reflect.Value) (netip.Prefix, bool) {
+	s, ok := stringValue(v)
+	if !ok {
+		return netip.Prefix{}, false
+	}
+
+	p, err := netip.ParsePrefix(s)
+	return p, err == nil
+}
+
+// parseIPRange parses the range of the value in the form of "first-last",
+// where first is lower than last and both addresses are of the same family.
+func parseIPRange(v reflect.Value) (ipRange, bool) {
+	s, ok := stringValue(v)
+	if !ok {
+		return ipRange{}, false
+	}
+
+	first, last, ok := strings.Cut(s, "-")
+	if !ok {
+		return ipRange{}, false
+	}
+
+	from, err := parseAddr(reflect.ValueOf(first))
+	if err != nil {
+		return ipRange{}, false
+	}
+	to, err := parseAddr(reflect.ValueOf(last))
+	if err != nil {
+		return ipRange{}, false
+	}
+
+	// An IPv4-mapped IPv6 address is not an IPv4 address.
+	if from.BitLen() != to.BitLen() || !from.Less(to) {
+		return ipRange{}, false
+	}
+
+	return ipRange{from: from, to: to}, true
+}
+
+// prefixRange returns the range of the addresses of the CIDR.
+func prefixRange(p netip.Prefix) ipRange {
+	from := p.Masked().Addr()
+
+	last := from.AsSlice()
+	for bit := p.Bits(); bit < len(last)*8; bit++ {
+		last[bit/8] |= 0x80 >> (bit % 8)
+	}
+	to, _ := netip.AddrFromSlice(last)
+
+	return ipRange{from: from, to: to}
+}
+
+// parsePrefixBounds parses the param of cidr_prefix: a prefix length (24)
+// or a range of prefix lengths (16-28).
+func parsePrefixBounds(param string) (minBits, maxBits int, ok bool) {
+	first, last, isRange := strings.Cut(param, "-")
+	if !isRange {
+		last = first
+	}
+
+	minBits, err := strconv.Atoi(first)
+	if err != nil {
+		return 0, 0, false
+	}
+	maxBits, err = strconv.Atoi(last)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return minBits, maxBits, minBits >= 0 && minBits <= maxBits && maxBits <= 128
+}
+
+// cidrField returns the CIDR of the field referenced by the param of the rule.
+// null is true if the field is null and ok is false if it is not a valid CIDR
+// or if the field does not exist.
+func cidrField(fl validator.FieldLevel) (p netip.Prefix, ok, null bool) {
+	v, found := lookupField(fl.Parent(), fl.Param())
+	if !found {
+		return netip.Prefix{}, false, false
+	}
+	if isNull(v) {
+		return netip.Prefix{}, false, true
+	}
+
+	p, ok = parsePrefix(v)
+	return p, ok, false
+}
+
 // TCPUDPPort is a custom validator that checks if a string is a valid TCP or UDP port.
 var TCPUDPPort = &CustomValidator{
 	Key: "tcp_udp_port",
@@ -0,0 +1,124 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package validators_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/orange-cloudavenue/common-go/validators"
+)
+
+type networkSubnet struct {
+	Subnet  string   `json:"subnet" validate:"omitempty,cidr_prefix=8-64"`
+	Gateway string   `json:"gateway" validate:"omitempty,ip_in_cidr=Subnet"`
+	Pools   []string `json:"pools" validate:"no_overlap,dive,range_in_cidr=subnet"`
+}
+
+func TestNetworkSubnet(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		input networkSubnet
+		rule  string
+	}{
+		{
+			name:  "valid",
+			input: networkSubnet{Subnet: "192.168.0.0/24", Gateway: "192.168.0.1", Pools: []string{"192.168.0.10-192.168.0.100", "192.168.0.101-192.168.0.200"}},
+		},
+		{
+			name:  "valid-ipv6",
+			input: networkSubnet{Subnet: "2001:db8::/64", Gateway: "2001:db8::1", Pools: []string{"2001:db8::10-2001:db8::ff"}},
+		},
+		{
+			name:  "no-subnet",
+			input: networkSubnet{Gateway: "10.0.0.1", Pools: []string{"10.0.0.10-10.0.0.100"}},
+		},
+		{
+			name:  "gateway-outside-subnet",
+			input: networkSubnet{Subnet: "192.168.0.0/24", Gateway: "192.168.1.1"},
+			rule:  "ip_in_cidr",
+		},
+		{
+			name:  "gateway-other-family",
+			input: networkSubnet{Subnet: "192.168.0.0/24", Gateway: "2001:db8::1"},
+			rule:  "ip_in_cidr",
+		},
+		{
+			name:  "invalid-subnet",
+			input: networkSubnet{Subnet: "192.168.0.0", Gateway: "192.168.0.1"},
+			rule:  "cidr_prefix",
+		},
+		{
+			name:  "pool-outside-subnet",
+			input: networkSubnet{Subnet: "192.168.0.0/24", Pools: []string{"192.168.0.200-192.168.1.10"}},
+			rule:  "range_in_cidr",
+		},
+		{
+			name:  "overlapping-pools",
+			input: networkSubnet{Subnet: "192.168.0.0/24", Pools: []string{"192.168.0.10-192.168.0.100", "192.168.0.50-192.168.0.150"}},
+			rule:  "no_overlap",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := validators.New().Struct(&tt.input)
+			if tt.rule == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assertRule(t, err, tt.rule)
+		})
+	}
+}
+
+func TestNetworkNetipTypes(t *testing.T) {
+	t.Parallel()
+	type network struct {
+		Subnet  netip.Prefix   `validate:"cidr_prefix=24"`
+		Gateway netip.Addr     `validate:"private_ip,ip_in_cidr=Subnet"`
+		Subnets []netip.Prefix `validate:"no_overlap"`
+	}
+
+	valid := network{
+		Subnet:  netip.MustParsePrefix("192.168.0.0/24"),
+		Gateway: netip.MustParseAddr("192.168.0.1"),
+		Subnets: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/24"), netip.MustParsePrefix("10.0.1.0/24")},
+	}
+	assert.NoError(t, validators.New().Struct(&valid))
+
+	invalid := valid
+	invalid.Gateway = netip.MustParseAddr("192.168.1.1")
+	assertRule(t, validators.New().Struct(&invalid), "ip_in_cidr")
+}
+
+func TestNetworkUnknownField(t *testing.T) {
+	t.Parallel()
+	type network struct {
+		Gateway string `validate:"ip_in_cidr=Unknown"`
+	}
+
+	// An unknown CIDR field fails the field.
+	assertRule(t, validators.New().Struct(&network{Gateway: "192.168.0.1"}), "ip_in_cidr")
+}
@@ -19,6 +19,8 @@
 package validators
 
 import (
+	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
@@ -30,69 +32,212 @@ var (
 
 	// RequireIfNull is a validator that requires a field if another field is null.
 	// It allows the field to be empty if the target field is set.
-	// Param is the name or a list of names of the target fields (separated by spaces)
-	// The name of the target field are in format 'GolangLike (EdgegatewayID) or paramsSpec (edgegateway_id)'.
+	// Param is the name or a list of names of the target fields (separated by spaces).
+	// The field is required only if all the target fields are null.
+	// The name of a target field is its Go name (EdgegatewayID), its snake name (edgegateway_id)
+	// or its JSON name, and can be a dotted path to a nested field (Network.ID).
+	// A value is null if it is a nil pointer or interface, an empty slice or map, or the zero
+	// value of its type (see isNull). The validation fails if a target field does not exist.
 	// Usage: `validate:"required_if_null=target_field"`
 	// E.g. `validate:"required_if_null=EdgegatewayID"`
 	RequireIfNull = &CustomValidator{
-		Key: "required_if_null",
+		Key:            "required_if_null",
+		CallEvenIfNull: true,
 		Func: func(fl validator.FieldLevel) bool {
-			// Field is already set, no need to validate
-			if fl.Field().String() != "" {
-				return true
-			}
-
-			target := fl.Param()
-			if strings.Contains(target, " ") {
-				// If the target is a list of fields, check if any of them is set
-				for _, field := range strings.Split(target, " ") {
-					if fl.Parent().FieldByName(strcase.ToPublicGoName(field)).String() != "" {
-						return true
-					}
-				}
+			nulls, ok := nullTargets(fl.Parent(), fl.Param())
+			if !ok {
 				return false
 			}
 
-			// Check if the parent field is null
-			if fl.Parent().FieldByName(strcase.ToPublicGoName(target)).String() == "" {
-				return false
+			// Field is already set, no need to validate
+			if !isNull(fieldValue(fl)) {
+				return true
 			}
 
-			return true
+			// The field is required if all the targets are null
+			return len(nulls) < len(strings.Fields(fl.Param()))
 		},
 	}
 
 	// ExcludeIfNull is a validator that excludes a field if another field is null.
-	// It allows the field to be empty if the target field is not set.
-	// Param is the name or a list of names of the target fields (separated by space)
-	// The name of the target field are in format 'GolangLike (EdgegatewayID) or paramsSpec (edgegateway_id)'.
+	// It allows the field to be set only if all the target fields are set.
+	// Param is the name or a list of names of the target fields (separated by spaces),
+	// with the same syntax and null semantics as RequireIfNull.
 	// Usage: `validate:"excluded_if_null=target_field"`
 	// E.g. `validate:"excluded_if_null=EdgegatewayID"`
 	ExcludeIfNull = &CustomValidator{
-		Key: "excluded_if_null",
+		Key:            "excluded_if_null",
+		CallEvenIfNull: true,
 		Func: func(fl validator.FieldLevel) bool {
-			// Field is already unset, no need to validate
-			if fl.Field().String() == "" {
-				return true
+			nulls, ok := nullTargets(fl.Parent(), fl.Param())
+			if !ok {
+				return false
 			}
 
-			target := fl.Param()
-			if strings.Contains(target, " ") {
-				// If the target is a list of fields, check if any of them is set
-				for _, field := range strings.Split(target, " ") {
-					if fl.Parent().FieldByName(strcase.ToPublicGoName(field)).String() == "" {
-						return false
-					}
-				}
+			// Field is already unset, no need to validate
+			if isNull(fieldValue(fl)) {
 				return true
 			}
 
-			// Check if the parent field is null
-			if fl.Parent().FieldByName(strcase.ToPublicGoName(target)).String() == "" {
-				return false
-			}
-
-			return true
+			// The field is excluded if any of the targets is null
+			return len(nulls) == 0
 		},
 	}
 )
+
+// isNull returns true if the value is a nil pointer or interface, an empty slice
+// or map, or the zero value of its type.
+func isNull(v reflect.Value) bool {
+	switch v.Kind() { //nolint:exhaustive // the other kinds are compared to their zero value
+	case reflect.Invalid:
+		return true
+	case reflect.Ptr, reflect.Interface, reflect.Chan, reflect.Func:
+		return v.IsNil()
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
+
+// fieldValue returns the value of the validated field. Unlike fl.Field(),
+// the pointers are not dereferenced: a pointer to an empty string is not null.
+func fieldValue(fl validator.FieldLevel) reflect.Value {
+	if parent := reflect.Indirect(fl.Parent()); parent.Kind() == reflect.Struct {
+		if f := parent.FieldByName(fl.StructFieldName()); f.IsValid() {
+			return f
+		}
+	}
+
+	return fl.Field()
+}
+
+// nullTargets returns the target fields of the param which are null in the parent struct.
+// The target fields which do not exist are null, and ok is false.
+func nullTargets(parent reflect.Value, param string) (targets []string, ok bool) {
+	ok = true
+	for _, target := range strings.Fields(param) {
+		v, found := lookupField(parent, target)
+		if !found {
+			ok = false
+		}
+		if isNull(v) {
+			targets = append(targets, target)
+		}
+	}
+
+	return targets, ok
+}
+
+// lookupField returns the value of the field at the dotted path in the struct.
+// Each segment is a Go name, a snake name or a JSON name. The value is invalid
+// (and null) if a pointer of the path is nil. It returns false if a field does not exist.
+func lookupField(v reflect.Value, path string) (reflect.Value, bool) {
+	for segment := range strings.SplitSeq(path, ".") {
+		for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				// The nested field of a nil struct is null, if it exists.
+				_, ok := lookupFieldType(v.Type(), segment)
+				return reflect.Value{}, ok
+			}
+			v = v.Elem()
+		}
+
+		f, ok := lookupFieldType(v.Type(), segment)
+		if !ok {
+			return reflect.Value{}, false
+		}
+		v = v.FieldByIndex(f.Index)
+	}
+
+	return v, true
+}
+
+// lookupFieldType returns the field of the struct type named segment.
+func lookupFieldType(t reflect.Type, segment string) (reflect.StructField, bool) {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Interface {
+		if t.Kind() == reflect.Interface {
+			return reflect.StructField{}, false
+		}
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return reflect.StructField{}, false
+	}
+
+	if f, ok := t.FieldByName(segment); ok {
+		return f, true
+	}
+	if f, ok := t.FieldByName(strcase.ToPublicGoName(segment)); ok {
+		return f, true
+	}
+
+	return t.FieldByNameFunc(func(name string) bool {
+		f, _ := t.FieldByName(name)
+		return jsonName(f) == segment
+	})
+}
+
+// jsonPath returns the path of the target field with the JSON names of the fields
+// (e.g. network.id for Network.ID). The unknown fields are kept as is.
+func jsonPath(t reflect.Type, path string) string {
+	var names []string
+	for segment := range strings.SplitSeq(path, ".") {
+		f, ok := lookupFieldType(t, segment)
+		if !ok {
+			return path
+		}
+		names = append(names, jsonName(f))
+		t = f.Type
+	}
+
+	return strings.Join(names, ".")
+}
+
+// lookupParent returns the struct containing the field at the struct namespace
+// of a validation error (e.g. EdgeGateway.Rules[1].Port), from the validated struct.
+func lookupParent(root reflect.Value, namespace string) (reflect.Value, bool) {
+	segments := strings.Split(namespace, ".")
+	if len(segments) < 2 {
+		return reflect.Value{}, false
+	}
+
+	v := reflect.Indirect(root)
+	for _, segment := range segments[1 : len(segments)-1] {
+		name, indexes, _ := strings.Cut(segment, "[")
+		v = reflect.Indirect(v)
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+		v = v.FieldByName(name)
+
+		// The indexes of the slices, arrays and maps (e.g. Rules[1] or Tags[key]).
+		for index := range strings.SplitSeq(strings.TrimSuffix(indexes, "]"), "][") {
+			if indexes == "" {
+				break
+			}
+			v = reflect.Indirect(v)
+			switch v.Kind() { //nolint:exhaustive // only the slices, arrays and maps have indexes
+			case reflect.Slice, reflect.Array:
+				i, err := strconv.Atoi(index)
+				if err != nil || i >= v.Len() {
+					return reflect.Value{}, false
+				}
+				v = v.Index(i)
+			case reflect.Map:
+				if v.Type().Key().Kind() != reflect.String {
+					return reflect.Value{}, false
+				}
+				v = v.MapIndex(reflect.ValueOf(index).Convert(v.Type().Key()))
+			default:
+				return reflect.Value{}, false
+			}
+		}
+		if !v.IsValid() {
+			return reflect.Value{}, false
+		}
+	}
+
+	v = reflect.Indirect(v)
+	return v, v.Kind() == reflect.Struct
+}
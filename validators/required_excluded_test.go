@@ -18,7 +18,11 @@
 
 package validators
 
-import "testing"
+import (
+	"errors"
+	"slices"
+	"testing"
+)
 
 func TestValidator_RequireIfNull(t *testing.T) {
 	type data struct {
@@ -129,3 +133,130 @@ func TestValidator_ExcludeIfNullWithMultipleFields(t *testing.T) {
 		})
 	}
 }
+
+func TestValidator_RequireIfNullKinds(t *testing.T) {
+	type network struct {
+		ID   *string `json:"id"`
+		Name string  `json:"name"`
+	}
+	type data struct {
+		Field1  *string `validate:"required_if_null=Pointer Count Enabled List Network.ID network.name"`
+		Pointer *string
+		Count   int
+		Enabled bool
+		List    []string
+		Network *network `json:"network"`
+	}
+
+	empty, value := "", "value"
+	tests := []struct {
+		name        string
+		input       data
+		expectedErr bool
+	}{
+		{name: "All targets are null, Field1 is nil", input: data{}, expectedErr: true},
+		{name: "All targets are null, Field1 points to an empty string", input: data{Field1: &empty}, expectedErr: false},
+		{name: "Pointer points to an empty string", input: data{Pointer: &empty}, expectedErr: false},
+		{name: "Count is set", input: data{Count: 1}, expectedErr: false},
+		{name: "Enabled is set", input: data{Enabled: true}, expectedErr: false},
+		{name: "List is empty", input: data{List: []string{}}, expectedErr: true},
+		{name: "List is set", input: data{List: []string{"value"}}, expectedErr: false},
+		{name: "Network is set, Network.ID is nil", input: data{Network: &network{}}, expectedErr: true},
+		{name: "Network.ID is set", input: data{Network: &network{ID: &value}}, expectedErr: false},
+		{name: "network.name is set", input: data{Network: &network{Name: "value"}}, expectedErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := New().Struct(&tt.input)
+			if (err == nil) == tt.expectedErr {
+				t.Errorf("expected validation result %v, got %v", tt.expectedErr, err == nil)
+			}
+		})
+	}
+}
+
+func TestValidator_ExcludeIfNullKinds(t *testing.T) {
+	type data struct {
+		Field1 *int `validate:"excluded_if_null=Count Nested.Enabled"`
+		Count  int
+		Nested struct {
+			Enabled *bool
+		}
+	}
+
+	zero, enabled := 0, false
+	tests := []struct {
+		name        string
+		input       data
+		expectedErr bool
+	}{
+		{name: "Field1 is nil", input: data{}, expectedErr: false},
+		{name: "Field1 points to 0, the targets are null", input: data{Field1: &zero}, expectedErr: true},
+		{name: "Field1 points to 0, Count is set", input: data{Field1: &zero, Count: 1}, expectedErr: true},
+		{name: "Field1 points to 0, all targets are set", input: data{Field1: &zero, Count: 1, Nested: struct{ Enabled *bool }{&enabled}}, expectedErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := New().Struct(&tt.input)
+			if (err == nil) == tt.expectedErr {
+				t.Errorf("expected validation result %v, got %v", tt.expectedErr, err == nil)
+			}
+		})
+	}
+}
+
+func TestValidator_ConditionalMissing(t *testing.T) {
+	type network struct {
+		ID      string `json:"id"`
+		Gateway string
+		Name    string `json:"name" validate:"excluded_if_null=ID Gateway"`
+	}
+	type data struct {
+		Networks []network `json:"networks" validate:"dive"`
+	}
+
+	input := data{Networks: []network{{ID: "id", Gateway: "gw", Name: "ok"}, {Gateway: "gw", Name: "name"}}}
+	var errs ValidationErrors
+	if !errors.As(New().Struct(&input), &errs) || len(errs) != 1 {
+		t.Fatalf("expected one validation error, got %v", errs)
+	}
+
+	if got, want := errs[0].Path, "networks[1].name"; got != want {
+		t.Errorf("Path = %q, want %q", got, want)
+	}
+	if got, want := errs[0].Missing, []string{"id"}; !slices.Equal(got, want) {
+		t.Errorf("Missing = %v, want %v", got, want)
+	}
+	if got, want := errs[0].Message, "name must not be set when no value is set for id"; got != want {
+		t.Errorf("Message = %q, want %q", got, want)
+	}
+}
+
+func TestValidator_ConditionalUnknownField(t *testing.T) {
+	type data struct {
+		Field1 string `validate:"required_if_null=Unknown"`
+		Field2 string `validate:"excluded_if_null=Field1 Unknown"`
+		Field3 string `validate:"exactly_one_of=Unknown"`
+	}
+
+	// An unknown target field fails the validation, even if the field is set.
+	err := New().Struct(&data{Field1: "set", Field2: "set", Field3: "set"})
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 3 {
+		t.Fatalf("expected three validation errors, got %v", err)
+	}
+
+	for i, rule := range []string{"required_if_null", "excluded_if_null", "exactly_one_of"} {
+		if errs[i].Rule != rule {
+			t.Errorf("Rule = %q, want %q", errs[i].Rule, rule)
+		}
+	}
+	for _, fe := range errs[:2] {
+		if got, want := fe.Missing, []string{"Unknown"}; !slices.Equal(got, want) {
+			t.Errorf("Missing = %v, want %v", got, want)
+		}
+	}
+}
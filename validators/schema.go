@@ -0,0 +1,626 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package validators
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/orange-cloudavenue/common-go/regex"
+	"github.com/orange-cloudavenue/common-go/urn"
+)
+
+// JSONSchemaDialect is the dialect of the schemas returned by JSONSchema.
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema (draft 2020-12). Without $schema, it is also an OpenAPI 3.1 schema object.
+type Schema struct {
+	Schema      string `json:"$schema,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Description string `json:"description,omitempty"`
+	Format      string `json:"format,omitempty"`
+	Pattern     string `json:"pattern,omitempty"`
+	Enum        []any  `json:"enum,omitempty"`
+	Default     any    `json:"default,omitempty"`
+
+	// * Numbers
+	Minimum          *float64 `json:"minimum,omitempty"`
+	Maximum          *float64 `json:"maximum,omitempty"`
+	ExclusiveMinimum *float64 `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum *float64 `json:"exclusiveMaximum,omitempty"`
+
+	// * Strings
+	MinLength       *int   `json:"minLength,omitempty"`
+	MaxLength       *int   `json:"maxLength,omitempty"`
+	ContentEncoding string `json:"contentEncoding,omitempty"`
+
+	// * Arrays
+	Items       *Schema `json:"items,omitempty"`
+	MinItems    *int    `json:"minItems,omitempty"`
+	MaxItems    *int    `json:"maxItems,omitempty"`
+	UniqueItems bool    `json:"uniqueItems,omitempty"`
+
+	// * Objects
+	Properties           map[string]*Schema  `json:"properties,omitempty"`
+	Required             []string            `json:"required,omitempty"`
+	AdditionalProperties *Schema             `json:"additionalProperties,omitempty"`
+	MinProperties        *int                `json:"minProperties,omitempty"`
+	MaxProperties        *int                `json:"maxProperties,omitempty"`
+	DependentRequired    map[string][]string `json:"dependentRequired,omitempty"`
+
+	// * Composition
+	AnyOf []*Schema `json:"anyOf,omitempty"`
+	AllOf []*Schema `json:"allOf,omitempty"`
+	OneOf []*Schema `json:"oneOf,omitempty"`
+	Not   *Schema   `json:"not,omitempty"`
+}
+
+// JSONSchema returns the JSON Schema (draft 2020-12) of the struct type of v
+// (a struct or a pointer to a struct) built from the json, validate and default tags.
+// The rules without equivalent in JSON Schema (e.g. eqfield) are ignored.
+func JSONSchema(v any) (*Schema, error) {
+	s, err := OpenAPISchema(v)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Schema = JSONSchemaDialect
+	return s, nil
+}
+
+// OpenAPISchema returns the OpenAPI 3.1 schema object of the struct type of v
+// (see JSONSchema), to be used as a component of an OpenAPI document.
+func OpenAPISchema(v any) (*Schema, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("validators: OpenAPISchema() expects a struct, got %v", t)
+	}
+
+	b := schemaBuilder{seen: make(map[reflect.Type]bool)}
+	return b.structSchema(t)
+}
+
+const (
+	// uuidPattern matches the UUIDs accepted by the urn package (see regex.UUIDDefault).
+	uuidPattern = regex.UUIDLayoutRegexString
+	// entitySegmentsPattern matches the vendor and NSS segments of the composite URNs
+	// followed by a colon (see urn.NewEntity).
+	entitySegmentsPattern = urn.EntitySegmentRegexString + ":" + urn.EntitySegmentRegexString + ":"
+	// entityVersionPattern matches the version of the EntityType URNs (e.g. 1.0.0).
+	entityVersionPattern = urn.EntityVersionRegexString
+	// portPattern matches the TCP and UDP ports (1-65535).
+	portPattern = `(?:[1-9][0-9]{0,3}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5])`
+	// ipv4Pattern matches the IPv4 addresses.
+	ipv4Pattern = `(?:(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\.){3}(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])`
+	// httpStatusCodePattern matches the HTTP status codes (100-599).
+	httpStatusCodePattern = `[1-5][0-9]{2}`
+)
+
+// formats are the JSON Schema formats of the go-playground rules.
+var formats = map[string]string{
+	"email":            "email",
+	"url":              "uri",
+	"http_url":         "uri",
+	"uri":              "uri",
+	"uuid":             "uuid",
+	"uuid4":            "uuid",
+	"uuid_rfc4122":     "uuid",
+	"ipv4":             "ipv4",
+	"ip4_addr":         "ipv4",
+	"ipv6":             "ipv6",
+	"ip6_addr":         "ipv6",
+	"hostname":         "hostname",
+	"hostname_rfc1123": "hostname",
+	"fqdn":             "hostname",
+}
+
+// patterns are the patterns of the rules without parameter.
+// The patterns are regular expressions of both RE2 and ECMA-262 (without flag),
+// as expected by the JSON Schema validators.
+var patterns = map[string]string{
+	"alpha":                 `^[a-zA-Z]+$`,
+	"alphanum":              `^[a-zA-Z0-9]+$`,
+	"numeric":               `^[-+]?[0-9]+(?:\.[0-9]+)?$`,
+	"lowercase":             `^[^A-Z]*$`,
+	"uppercase":             `^[^a-z]*$`,
+	DisallowUpper.Key:       `^[^A-Z]*$`,
+	DisallowSpace.Key:       `^\S*$`,
+	KeyValue.Key:            `^([a-zA-Z0-9_]+=[a-zA-Z0-9_]+)$`,
+	IPV4Range.Key:           `^` + ipv4Pattern + `-` + ipv4Pattern + `$`,
+	TCPUDPPortRange.Key:     `^` + portPattern + `-` + portPattern + `$`,
+	HTTPStatusCodeRange.Key: `^` + httpStatusCodePattern + `-` + httpStatusCodePattern + `$`,
+}
+
+// descriptions describe the constraints of the rules which the patterns only check
+// for the ASCII letters, as the Unicode classes (e.g. \p{Lu}) are not part of ECMA-262 without flag.
+var descriptions = map[string]string{
+	"lowercase":       "Must not contain uppercase letters.",
+	"uppercase":       "Must not contain lowercase letters.",
+	DisallowUpper.Key: "Must not contain uppercase letters.",
+}
+
+// casePatterns are the patterns of the case rule indexed by parameter.
+var casePatterns = map[string]string{
+	"camelCase":  regex.CamelCaseRegexString,
+	"snake_case": regex.SnakeCaseRegexString,
+	"PascalCase": regex.PascalCaseRegexString,
+	"UPPER_CASE": regex.UpperCaseRegexString,
+	"kebab-case": regex.KebabCaseRegexString,
+}
+
+var (
+	timeType          = reflect.TypeFor[time.Time]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+type schemaBuilder struct {
+	// seen are the struct types being built, to detect the recursive types.
+	seen map[reflect.Type]bool
+}
+
+// conditionalRule is a rule depending on other fields of the struct (e.g. required_if_null).
+type conditionalRule struct {
+	// field is the JSON name of the field and goName its Go name.
+	field, goName, rule, param string
+}
+
+func (b schemaBuilder) structSchema(t reflect.Type) (*Schema, error) {
+	if b.seen[t] {
+		return nil, fmt.Errorf("validators: recursive type %s is not supported", t)
+	}
+	b.seen[t] = true
+	defer delete(b.seen, t)
+
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	var conditionals []conditionalRule
+
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tagName, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if tagName == "-" {
+			continue
+		}
+
+		// The fields of the embedded structs are promoted.
+		if ft := indirect(f.Type); f.Anonymous && tagName == "" && ft.Kind() == reflect.Struct {
+			es, err := b.structSchema(ft)
+			if err != nil {
+				return nil, err
+			}
+			for name, ps := range es.Properties {
+				s.Properties[name] = ps
+			}
+			s.Required = append(s.Required, es.Required...)
+			s.AllOf = append(s.AllOf, es.AllOf...)
+			for name, deps := range es.DependentRequired {
+				if s.DependentRequired == nil {
+					s.DependentRequired = make(map[string][]string)
+				}
+				s.DependentRequired[name] = deps
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+
+		name := jsonName(f)
+
+		fs, err := b.typeSchema(f.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", t.Name(), f.Name, err)
+		}
+
+		if def, ok := f.Tag.Lookup("default"); ok {
+			fs.Default = defaultValue(indirect(f.Type), def)
+		}
+
+		required, conds := applyRules(fs, f.Type, f.Tag.Get("validate"))
+		if required {
+			s.Required = append(s.Required, name)
+		}
+		for _, c := range conds {
+			c.field, c.goName = name, f.Name
+			conditionals = append(conditionals, c)
+		}
+
+		s.Properties[name] = fs
+	}
+
+	for _, c := range conditionals {
+		// Only the sibling fields can be referenced in the schema of the struct.
+		var targets []string
+		params := strings.Fields(c.param)
+		if isGroupRule(c.rule) {
+			params = groupTargets(t, c.goName, c.param)
+		}
+		for _, target := range params {
+			if f, ok := lookupFieldType(t, target); ok && !strings.Contains(target, ".") {
+				targets = append(targets, jsonName(f))
+			}
+		}
+		if len(targets) == 0 || len(targets) != len(params) {
+			continue
+		}
+
+		switch c.rule {
+		case RequireIfNull.Key:
+			// The field or one of the targets is set.
+			anyOf := []*Schema{{Required: []string{c.field}}}
+			for _, target := range targets {
+				anyOf = append(anyOf, &Schema{Required: []string{target}})
+			}
+			s.AllOf = append(s.AllOf, &Schema{AnyOf: anyOf})
+		case ExcludeIfNull.Key:
+			// The field is set only if all the targets are set.
+			if s.DependentRequired == nil {
+				s.DependentRequired = make(map[string][]string)
+			}
+			s.DependentRequired[c.field] = append(s.DependentRequired[c.field], targets...)
+		case ExactlyOneOf.Key, AtLeastOneOf.Key:
+			// One (or at least one) of the fields of the group is set.
+			members := []*Schema{{Required: []string{c.field}}}
+			for _, target := range targets {
+				members = append(members, &Schema{Required: []string{target}})
+			}
+			if c.rule == ExactlyOneOf.Key {
+				s.AllOf = append(s.AllOf, &Schema{OneOf: members})
+			} else {
+				s.AllOf = append(s.AllOf, &Schema{AnyOf: members})
+			}
+		case AllOrNoneOf.Key:
+			// Each field of the group requires the others.
+			if s.DependentRequired == nil {
+				s.DependentRequired = make(map[string][]string)
+			}
+			members := append([]string{c.field}, targets...)
+			for _, member := range members {
+				for _, other := range members {
+					if other != member && !slices.Contains(s.DependentRequired[member], other) {
+						s.DependentRequired[member] = append(s.DependentRequired[member], other)
+					}
+				}
+			}
+		case ConflictsWith.Key:
+			// The field and a target are not set together.
+			for _, target := range targets {
+				s.AllOf = append(s.AllOf, &Schema{Not: &Schema{Required: []string{c.field, target}}})
+			}
+		}
+	}
+
+	return s, nil
+}
+
+func (b schemaBuilder) typeSchema(t reflect.Type) (*Schema, error) {
+	t = indirect(t)
+
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}, nil
+	case t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType):
+		return &Schema{Type: "string"}, nil
+	}
+
+	switch t.Kind() { //nolint:exhaustive // the other kinds are not supported
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: "integer"}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Minimum: ptr(0.0)}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}, nil
+	case reflect.String:
+		return &Schema{Type: "string"}, nil
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			// encoding/json encodes []byte as a base64 string.
+			return &Schema{Type: "string", ContentEncoding: "base64"}, nil
+		}
+		items, err := b.typeSchema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	case reflect.Map:
+		values, err := b.typeSchema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "object", AdditionalProperties: values}, nil
+	case reflect.Struct:
+		return b.structSchema(t)
+	case reflect.Interface:
+		return &Schema{}, nil
+	default:
+		return nil, fmt.Errorf("validators: unsupported kind %s", t.Kind())
+	}
+}
+
+// applyRules applies the rules of the validate tag to the schema of the field.
+// It returns true if the field is required and the rules depending on other fields.
+func applyRules(s *Schema, t reflect.Type, tag string) (required bool, conds []conditionalRule) {
+	target, targetType := s, indirect(t)
+
+	for rule := range strings.SplitSeq(tag, ",") {
+		switch rule {
+		case "", "omitempty", "omitnil":
+			continue
+		case "required":
+			// A required after dive applies to the items.
+			required = required || target == s
+			continue
+		case "dive":
+			// The next rules apply to the items of the array or the values of the map.
+			switch {
+			case target.Items != nil:
+				target = target.Items
+			case target.AdditionalProperties != nil:
+				target = target.AdditionalProperties
+			default:
+				return required, conds
+			}
+			targetType = indirect(targetType.Elem())
+			continue
+		}
+
+		if strings.Contains(rule, "|") {
+			// The alternatives of an OR.
+			var anyOf []*Schema
+			for alt := range strings.SplitSeq(rule, "|") {
+				sub := &Schema{}
+				applyRule(sub, targetType, alt)
+				anyOf = append(anyOf, sub)
+			}
+			target.AnyOf = append(target.AnyOf, anyOf...)
+			continue
+		}
+
+		key, _, _ := strings.Cut(rule, "=")
+		if key == RequireIfNull.Key || key == ExcludeIfNull.Key || isGroupRule(key) {
+			_, param, _ := strings.Cut(rule, "=")
+			conds = append(conds, conditionalRule{rule: key, param: param})
+			continue
+		}
+
+		applyRule(target, targetType, rule)
+	}
+
+	return required, conds
+}
+
+// applyRule applies the rule (key=param) to the schema of a value of the type t.
+func applyRule(s *Schema, t reflect.Type, rule string) {
+	key, param, _ := strings.Cut(rule, "=")
+	param = strings.NewReplacer("0x2C", ",", "0x7C", "|").Replace(param)
+
+	if f, ok := formats[key]; ok {
+		s.Format = f
+		return
+	}
+	if p, ok := patterns[key]; ok {
+		addPattern(s, p)
+		addDescription(s, descriptions[key])
+		return
+	}
+
+	switch key {
+	case "min", "max", "len", "gt", "gte", "lt", "lte":
+		applyBound(s, t, key, param)
+	case "oneof":
+		for value := range strings.FieldsSeq(param) {
+			s.Enum = append(s.Enum, parseValue(t, strings.Trim(value, "'")))
+		}
+	case "unique":
+		s.UniqueItems = true
+	case "startswith":
+		addPattern(s, "^"+regexp.QuoteMeta(param))
+	case "endswith":
+		addPattern(s, regexp.QuoteMeta(param)+"$")
+	case "contains":
+		addPattern(s, regexp.QuoteMeta(param))
+	case Case.Key:
+		if p, ok := casePatterns[param]; ok {
+			addPattern(s, p)
+		}
+	case CAVResourceName.Key:
+		for _, resource := range regex.ListCavResourceNames {
+			if resource.Key == param {
+				addPattern(s, resource.RegexString)
+			}
+		}
+	case URN.Key:
+		// The rule also validates the items of the arrays.
+		for s.Items != nil {
+			s = s.Items
+		}
+		if p, ok := parseURNParam(param); ok {
+			addPattern(s, urnPattern(p))
+		}
+	case URNReference.Key:
+		if id, ok := s.Properties["id"]; ok {
+			p := urnParam{}
+			if param != "" {
+				p, ok = parseURNParam(param)
+			}
+			if ok {
+				addPattern(id, urnPattern(p))
+			}
+		}
+	case TCPUDPPort.Key:
+		if t.Kind() == reflect.String {
+			addPattern(s, "^"+portPattern+"$")
+		} else {
+			s.Minimum, s.Maximum = ptr(1.0), ptr(65535.0)
+		}
+	case HTTPStatusCode.Key:
+		if t.Kind() == reflect.String {
+			addPattern(s, "^"+httpStatusCodePattern+"$")
+		} else {
+			s.Minimum, s.Maximum = ptr(100.0), ptr(599.0)
+		}
+	}
+}
+
+// applyBound applies a min, max, len, gt, gte, lt or lte rule. The bound is
+// a length for the strings, arrays and maps and a value for the numbers.
+func applyBound(s *Schema, t reflect.Type, key, param string) {
+	v, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return
+	}
+
+	switch t.Kind() { //nolint:exhaustive // the numbers are handled by default
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		n := int(v)
+		minPtr, maxPtr := &s.MinLength, &s.MaxLength
+		switch t.Kind() { //nolint:exhaustive // only the strings, arrays and maps
+		case reflect.Slice, reflect.Array:
+			minPtr, maxPtr = &s.MinItems, &s.MaxItems
+		case reflect.Map:
+			minPtr, maxPtr = &s.MinProperties, &s.MaxProperties
+		}
+		switch key {
+		case "min", "gte":
+			*minPtr = ptr(n)
+		case "gt":
+			*minPtr = ptr(n + 1)
+		case "max", "lte":
+			*maxPtr = ptr(n)
+		case "lt":
+			*maxPtr = ptr(n - 1)
+		case "len":
+			*minPtr, *maxPtr = ptr(n), ptr(n)
+		}
+	default:
+		switch key {
+		case "min", "gte":
+			s.Minimum = ptr(v)
+		case "gt":
+			s.ExclusiveMinimum = ptr(v)
+		case "max", "lte":
+			s.Maximum = ptr(v)
+		case "lt":
+			s.ExclusiveMaximum = ptr(v)
+		case "len":
+			s.Minimum, s.Maximum = ptr(v), ptr(v)
+		}
+	}
+}
+
+// urnPattern returns the pattern of the URNs accepted by the urn rule.
+func urnPattern(p urnParam) string {
+	types := p.types
+	if len(types) == 0 {
+		for _, info := range urn.All() {
+			types = append(types, info.Type)
+		}
+	}
+
+	alternatives := make([]string, 0, len(types)+1)
+	for _, t := range types {
+		switch t {
+		case urn.Entity:
+			alternatives = append(alternatives, regexp.QuoteMeta(t.String())+entitySegmentsPattern+uuidPattern)
+		case urn.EntityType:
+			alternatives = append(alternatives, regexp.QuoteMeta(t.String())+entitySegmentsPattern+entityVersionPattern)
+		default:
+			alternatives = append(alternatives, regexp.QuoteMeta(t.String())+uuidPattern)
+		}
+	}
+	if p.bareUUID {
+		alternatives = append(alternatives, uuidPattern)
+	}
+
+	return "^(?:" + strings.Join(alternatives, "|") + ")$"
+}
+
+// addPattern adds the pattern to the schema. The schema must match all its patterns.
+func addPattern(s *Schema, pattern string) {
+	if s.Pattern == "" {
+		s.Pattern = pattern
+		return
+	}
+
+	s.AllOf = append(s.AllOf, &Schema{Pattern: pattern})
+}
+
+// addDescription adds the sentence to the description of the schema.
+func addDescription(s *Schema, description string) {
+	if description == "" {
+		return
+	}
+	if s.Description != "" {
+		s.Description += " "
+	}
+
+	s.Description += description
+}
+
+// defaultValue returns the value of the default tag as encoded in JSON.
+func defaultValue(t reflect.Type, value string) any {
+	if t.Kind() == reflect.String {
+		return value
+	}
+
+	// The non-string values are parsed as JSON, like the defaults package does for
+	// the slices, maps and structs. The other values (e.g. a time.Duration) are kept as is.
+	v := reflect.New(t)
+	if err := json.Unmarshal([]byte(value), v.Interface()); err != nil {
+		return value
+	}
+
+	return v.Elem().Interface()
+}
+
+// parseValue returns the value of a oneof parameter in the type of the field.
+func parseValue(t reflect.Type, value string) any {
+	if t.Kind() == reflect.String {
+		return value
+	}
+	if v, err := strconv.ParseFloat(value, 64); err == nil {
+		return v
+	}
+
+	return value
+}
+
+// indirect returns the type pointed by t, if t is a pointer.
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
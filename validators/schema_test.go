@@ -0,0 +1,311 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package validators_test
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/orange-cloudavenue/common-go/regex"
+	"github.com/orange-cloudavenue/common-go/urn"
+	"github.com/orange-cloudavenue/common-go/validators"
+)
+
+type schemaBase struct {
+	ID string `json:"id" validate:"required,urn=vdc vdcGroup"`
+}
+
+type schemaRule struct {
+	Port     int      `json:"port" validate:"required,tcp_udp_port"`
+	Protocol string   `json:"protocol" validate:"omitempty,oneof=tcp udp" default:"tcp"`
+	Sources  []string `json:"sources,omitempty" validate:"omitempty,min=1,max=10,dive,ipv4|ipv6"`
+}
+
+type schemaEdgeGateway struct {
+	schemaBase
+
+	Name      string            `json:"name" validate:"required,resource_name=edgegateway"`
+	Label     string            `json:"label" validate:"case=snake_case,min=2,max=27"`
+	Owner     urn.Reference     `json:"owner" validate:"urn_reference=vdc"`
+	Rules     []schemaRule      `json:"rules" validate:"dive"`
+	Tags      map[string]string `json:"tags,omitempty"`
+	Enabled   *bool             `json:"enabled,omitempty" default:"true"`
+	Timeout   time.Duration     `json:"timeout" default:"30s"`
+	Retries   uint8             `json:"retries" validate:"lte=5" default:"3"`
+	CreatedAt time.Time         `json:"created_at"`
+	VDC       string            `json:"vdc,omitempty" validate:"required_if_null=VDCGroup"`
+	VDCGroup  string            `json:"vdc_group,omitempty" validate:"excluded_if_null=VDC"`
+	Ignored   string            `json:"-"`
+	internal  string            //nolint:unused // the unexported fields are ignored
+}
+
+func TestJSONSchema(t *testing.T) {
+	t.Parallel()
+	s, err := validators.JSONSchema(&schemaEdgeGateway{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, validators.JSONSchemaDialect, s.Schema)
+	assert.Equal(t, "object", s.Type)
+	assert.Equal(t, []string{"id", "name"}, s.Required)
+	assert.NotContains(t, s.Properties, "Ignored")
+	assert.NotContains(t, s.Properties, "internal")
+
+	// Custom rules.
+	assert.Equal(t, regex.EdgeGatewayNameRegexString, s.Properties["name"].Pattern)
+	assert.Equal(t, regex.SnakeCaseRegexString, s.Properties["label"].Pattern)
+	assert.Equal(t, 2, *s.Properties["label"].MinLength)
+	assert.Equal(t, 27, *s.Properties["label"].MaxLength)
+
+	rule := s.Properties["rules"].Items
+	assert.Equal(t, "integer", rule.Properties["port"].Type)
+	assert.Equal(t, 1.0, *rule.Properties["port"].Minimum)
+	assert.Equal(t, 65535.0, *rule.Properties["port"].Maximum)
+	assert.Equal(t, []string{"port"}, rule.Required)
+
+	// Built-in rules and defaults.
+	assert.Equal(t, []any{"tcp", "udp"}, rule.Properties["protocol"].Enum)
+	assert.Equal(t, "tcp", rule.Properties["protocol"].Default)
+	assert.Equal(t, 1, *rule.Properties["sources"].MinItems)
+	assert.Equal(t, 10, *rule.Properties["sources"].MaxItems)
+	assert.Equal(t, []*validators.Schema{{Format: "ipv4"}, {Format: "ipv6"}}, rule.Properties["sources"].Items.AnyOf)
+	assert.Equal(t, true, s.Properties["enabled"].Default)
+	assert.Equal(t, "30s", s.Properties["timeout"].Default)
+	assert.Equal(t, uint8(3), s.Properties["retries"].Default)
+	assert.Equal(t, 5.0, *s.Properties["retries"].Maximum)
+	assert.Equal(t, "date-time", s.Properties["created_at"].Format)
+	assert.Equal(t, "string", s.Properties["tags"].AdditionalProperties.Type)
+
+	// Conditional rules.
+	assert.Equal(t, map[string][]string{"vdc_group": {"vdc"}}, s.DependentRequired)
+	assert.Equal(t, []*validators.Schema{{AnyOf: []*validators.Schema{{Required: []string{"vdc"}}, {Required: []string{"vdc_group"}}}}}, s.AllOf)
+
+	// The schema is valid JSON.
+	_, err = json.Marshal(s)
+	assert.NoError(t, err)
+}
+
+func TestJSONSchema_Groups(t *testing.T) {
+	t.Parallel()
+	type group struct {
+		VDC      string `json:"vdc,omitempty" validate:"exactly_one_of=VDC VDCGroup"`
+		VDCGroup string `json:"vdc_group,omitempty" validate:"exactly_one_of=VDC VDCGroup"`
+		Username string `json:"username,omitempty" validate:"all_or_none_of=Password"`
+		Password string `json:"password,omitempty"`
+		Token    string `json:"token,omitempty" validate:"conflicts_with=Username Password"`
+		Name     string `json:"name,omitempty" validate:"at_least_one_of=Token"`
+	}
+
+	s, err := validators.JSONSchema(&group{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	required := func(names ...string) *validators.Schema { return &validators.Schema{Required: names} }
+	oneOf := &validators.Schema{OneOf: []*validators.Schema{required("vdc"), required("vdc_group")}}
+	assert.Equal(t, []*validators.Schema{
+		oneOf,
+		{OneOf: []*validators.Schema{required("vdc_group"), required("vdc")}},
+		{Not: required("token", "username")},
+		{Not: required("token", "password")},
+		{AnyOf: []*validators.Schema{required("name"), required("token")}},
+	}, s.AllOf)
+	assert.Equal(t, map[string][]string{"username": {"password"}, "password": {"username"}}, s.DependentRequired)
+}
+
+func TestJSONSchema_URNPatterns(t *testing.T) {
+	t.Parallel()
+	s, err := validators.OpenAPISchema(schemaEdgeGateway{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Empty(t, s.Schema)
+
+	id := regexp.MustCompile(s.Properties["id"].Pattern)
+	assert.True(t, id.MatchString("urn:vcloud:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12"))
+	assert.True(t, id.MatchString("urn:vcloud:vdcGroup:4aeb40d8-038c-4e77-8181-a7054f583b12"))
+	assert.False(t, id.MatchString("urn:vcloud:vm:4aeb40d8-038c-4e77-8181-a7054f583b12"))
+	assert.False(t, id.MatchString("urn:vcloud:vdc:not-a-uuid"))
+
+	owner := regexp.MustCompile(s.Properties["owner"].Properties["id"].Pattern)
+	assert.True(t, owner.MatchString("urn:vcloud:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12"))
+	assert.False(t, owner.MatchString("urn:vcloud:vdcGroup:4aeb40d8-038c-4e77-8181-a7054f583b12"))
+}
+
+func TestJSONSchema_PatternsMatchValidators(t *testing.T) {
+	t.Parallel()
+	type values struct {
+		Port      string `json:"port" validate:"tcp_udp_port"`
+		PortRange string `json:"port_range" validate:"tcp_udp_port_range"`
+		IPRange   string `json:"ip_range" validate:"ipv4_range"`
+		Status    string `json:"status" validate:"http_status_code"`
+		AnyURN    string `json:"any_urn" validate:"urn=any"`
+		Legacy    string `json:"legacy" validate:"urn=vdc uuid"`
+		KeyValue  string `json:"key_value" validate:"str_key_value"`
+		Type      string `json:"type" validate:"urn=entityType"`
+		Lower     string `json:"lower" validate:"disallow_upper"`
+	}
+
+	s, err := validators.JSONSchema(values{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for property, tests := range map[string]map[string]bool{
+		"port":       {"1": true, "65535": true, "0": false, "65536": false, "080": false},
+		"port_range": {"80-443": true, "80-65536": false},
+		"ip_range":   {"192.168.0.1-192.168.0.100": true, "192.168.0.256-192.168.0.300": false},
+		"status":     {"200": true, "600": false},
+		"any_urn":    {"urn:cloudavenue:vcda:4aeb40d8-038c-4e77-8181-a7054f583b12": true, "urn:vcloud:entity:vmware:tkgcluster:4aeb40d8-038c-4e77-8181-a7054f583b12": true, "urn:vcloud:unknown:4aeb40d8-038c-4e77-8181-a7054f583b12": false},
+		"legacy":     {"4aeb40d8-038c-4e77-8181-a7054f583b12": true, "urn:vcloud:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12": true},
+		"key_value":  {"key=value": true, "key=value=val": false},
+		"type":       {"urn:vcloud:type:vmware:tkgcluster:1.0.0": true, "urn:vcloud:type:vmware:tkgcluster:latest": false, "urn:vcloud:type:-vmware:tkgcluster:1.0.0": false},
+		"lower":      {"lower case": true, "Upper": false},
+	} {
+		re := regexp.MustCompile(s.Properties[property].Pattern)
+		for value, want := range tests {
+			assert.Equal(t, want, re.MatchString(value), "%s: %s", property, value)
+		}
+	}
+}
+
+// TestJSONSchema_URNPatternsMatchValidator checks that the URN patterns accept
+// exactly the values accepted by the urn validator.
+func TestJSONSchema_URNPatternsMatchValidator(t *testing.T) {
+	t.Parallel()
+	type values struct {
+		AnyURN string `json:"any_urn" validate:"urn=any"`
+		Legacy string `json:"legacy" validate:"urn=vdc uuid"`
+		Entity string `json:"entity" validate:"urn=entity entityType"`
+	}
+
+	s, err := validators.JSONSchema(values{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	const id = "4aeb40d8-038c-4e77-8181-a7054f583b12"
+	inputs := []string{
+		id,
+		strings.ToUpper(id),
+		"4aeb40d8-038c-1e77-0181-a7054f583b12",
+		"4aeb40d8038c4e778181a7054f583b12",
+		id + "0",
+		"urn:vcloud:vdc:" + id,
+		"urn:vcloud:vdc:" + strings.ToUpper(id),
+		"urn:vcloud:vdc:" + id[1:],
+		"URN:VCLOUD:VDC:" + id,
+		"urn:vcloud:VDC:" + id,
+		"urn:vcloud:vm:" + id,
+		"urn:cloudavenue:vcda:" + id,
+		"urn:vcloud:unknown:" + id,
+		"urn:vcloud:entity:vmware:tkgcluster:" + id,
+		"urn:vcloud:entity:vmware.com:tkg_cluster-1:" + id,
+		"urn:vcloud:entity:-vmware:tkgcluster:" + id,
+		"urn:vcloud:entity:vmware:" + id,
+		"urn:vcloud:entity:vmware:tkgcluster:1.0.0",
+		"urn:vcloud:type:vmware:tkgcluster:1.0.0",
+		"urn:vcloud:type:vmware:tkgcluster:10.20.30",
+		"urn:vcloud:type:vmware:tkgcluster:1.0",
+		"urn:vcloud:type:vmware:tkgcluster:latest",
+		"urn:vcloud:type:vmware:tkgcluster:" + id,
+		"urn:vcloud:type:vmware:tkg:cluster:1.0.0",
+	}
+
+	v := validators.New()
+	for property, rule := range map[string]string{"any_urn": "urn=any", "legacy": "urn=vdc uuid", "entity": "urn=entity entityType"} {
+		re := regexp.MustCompile(s.Properties[property].Pattern)
+		for _, input := range inputs {
+			assert.Equal(t, v.Var(input, rule) == nil, re.MatchString(input), "%s: %s", rule, input)
+		}
+	}
+}
+
+// collectPatterns returns the patterns of the schema and of its subschemas.
+func collectPatterns(s *validators.Schema) []string {
+	if s == nil {
+		return nil
+	}
+
+	var patterns []string
+	if s.Pattern != "" {
+		patterns = append(patterns, s.Pattern)
+	}
+	patterns = append(patterns, collectPatterns(s.Items)...)
+	for _, p := range s.Properties {
+		patterns = append(patterns, collectPatterns(p)...)
+	}
+	for _, sub := range append(s.AllOf, s.AnyOf...) {
+		patterns = append(patterns, collectPatterns(sub)...)
+	}
+
+	return patterns
+}
+
+func TestJSONSchema_ECMAPatterns(t *testing.T) {
+	t.Parallel()
+	type values struct {
+		Lower    string   `json:"lower" validate:"lowercase"`
+		Upper    string   `json:"upper" validate:"uppercase"`
+		NoUpper  string   `json:"no_upper" validate:"disallow_upper,disallow_space"`
+		Label    string   `json:"label" validate:"case=camelCase"`
+		Resource string   `json:"resource" validate:"resource_name=t0"`
+		URNs     []string `json:"urns" validate:"urn=any"`
+	}
+
+	s, err := validators.JSONSchema(values{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// The RE2 constructs which are not part of ECMA-262 without flag.
+	re2Only := regexp.MustCompile(`\\[pPzAQ]|\(\?[imsU]|\(\?P<`)
+	patterns := collectPatterns(s)
+	assert.NotEmpty(t, patterns)
+	for _, p := range patterns {
+		assert.False(t, re2Only.MatchString(p), "RE2 only pattern: %s", p)
+	}
+
+	assert.Equal(t, "Must not contain uppercase letters.", s.Properties["no_upper"].Description)
+}
+
+func TestJSONSchema_Errors(t *testing.T) {
+	t.Parallel()
+	type node struct {
+		Children []node `json:"children"`
+	}
+
+	_, err := validators.JSONSchema(node{})
+	assert.ErrorContains(t, err, "recursive type")
+
+	_, err = validators.JSONSchema("not a struct")
+	assert.Error(t, err)
+
+	type unsupported struct {
+		C chan int `json:"c"`
+	}
+	_, err = validators.JSONSchema(unsupported{})
+	assert.ErrorContains(t, err, "unsupported kind")
+}
@@ -0,0 +1,249 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package validators
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/locales"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/fr"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	entranslations "github.com/go-playground/validator/v10/translations/en"
+	frtranslations "github.com/go-playground/validator/v10/translations/fr"
+)
+
+// DefaultLocale is the locale of the messages when no locale is set (see WithLocale).
+const DefaultLocale = "en"
+
+// messages are the messages of the custom validators indexed by locale and by key.
+// {0} is the name of the field and {1} the parameter of the rule.
+// The keys suffixed by "_any" are used when the rule has no parameter.
+var messages = map[string]map[string]string{
+	"en": {
+		"default":                "{0} is not valid ({1})",
+		"or":                     " or ",
+		"disallow_upper":         "{0} must not contain uppercase characters",
+		"disallow_space":         "{0} must not contain spaces",
+		"case":                   "{0} must be in {1}",
+		"str_key_value":          "{0} must be a key=value pair",
+		"urn":                    "{0} must be a valid URN of type {1}",
+		"urn_any":                "{0} must be a valid URN",
+		"urn_reference":          "{0} must reference a valid URN of type {1}",
+		"urn_reference_any":      "{0} must reference a valid URN",
+		"resource_name":          "{0} must be a valid {1} resource name",
+		"ipv4_range":             "{0} must be a valid IPv4 range (e.g. 192.168.0.1-192.168.0.100)",
+		"ipv6_range":             "{0} must be a valid IPv6 range (e.g. 2001:db8::1-2001:db8::ff)",
+		"ip_range":               "{0} must be a valid IPv4 or IPv6 range (e.g. 192.168.0.1-192.168.0.100)",
+		"cidr_prefix":            "{0} must be a valid CIDR with a prefix length of {1}",
+		"ip_in_cidr":             "{0} must be an IP address within the CIDR of {1}",
+		"range_in_cidr":          "{0} must be an IP range within the CIDR of {1}",
+		"private_ip":             "{0} must be a private IP address",
+		"no_overlap":             "{0} must not contain overlapping CIDRs or IP ranges",
+		"tcp_udp_port":           "{0} must be a valid TCP or UDP port (1-65535)",
+		"tcp_udp_port_range":     "{0} must be a valid TCP or UDP port range (e.g. 80-443)",
+		"http_status_code":       "{0} must be a valid HTTP status code (100-599)",
+		"http_status_code_range": "{0} must be a valid HTTP status code range (e.g. 200-299)",
+		"required_if_null":       "{0} is required when no value is set for {1}",
+		"excluded_if_null":       "{0} must not be set when no value is set for {1}",
+		"exactly_one_of":         "exactly one of {0}, {1} must be set",
+		"at_least_one_of":        "at least one of {0}, {1} must be set",
+		"all_or_none_of":         "{0}, {1} must be either all set or all unset",
+		"conflicts_with":         "{0} conflicts with {1}",
+	},
+	"fr": {
+		"default":                "{0} n'est pas valide ({1})",
+		"or":                     " ou ",
+		"disallow_upper":         "{0} ne doit pas contenir de majuscules",
+		"disallow_space":         "{0} ne doit pas contenir d'espaces",
+		"case":                   "{0} doit être au format {1}",
+		"str_key_value":          "{0} doit être une paire clé=valeur",
+		"urn":                    "{0} doit être un URN valide de type {1}",
+		"urn_any":                "{0} doit être un URN valide",
+		"urn_reference":          "{0} doit référencer un URN valide de type {1}",
+		"urn_reference_any":      "{0} doit référencer un URN valide",
+		"resource_name":          "{0} doit être un nom de ressource {1} valide",
+		"ipv4_range":             "{0} doit être une plage IPv4 valide (ex. 192.168.0.1-192.168.0.100)",
+		"ipv6_range":             "{0} doit être une plage IPv6 valide (ex. 2001:db8::1-2001:db8::ff)",
+		"ip_range":               "{0} doit être une plage IPv4 ou IPv6 valide (ex. 192.168.0.1-192.168.0.100)",
+		"cidr_prefix":            "{0} doit être un CIDR valide avec une longueur de préfixe de {1}",
+		"ip_in_cidr":             "{0} doit être une adresse IP comprise dans le CIDR de {1}",
+		"range_in_cidr":          "{0} doit être une plage IP comprise dans le CIDR de {1}",
+		"private_ip":             "{0} doit être une adresse IP privée",
+		"no_overlap":             "{0} ne doit pas contenir de CIDR ou de plages IP qui se chevauchent",
+		"tcp_udp_port":           "{0} doit être un port TCP ou UDP valide (1-65535)",
+		"tcp_udp_port_range":     "{0} doit être une plage de ports TCP ou UDP valide (ex. 80-443)",
+		"http_status_code":       "{0} doit être un code de statut HTTP valide (100-599)",
+		"http_status_code_range": "{0} doit être une plage de codes de statut HTTP valide (ex. 200-299)",
+		"required_if_null":       "{0} est obligatoire lorsqu'aucune valeur n'est définie pour {1}",
+		"excluded_if_null":       "{0} ne doit pas être défini lorsqu'aucune valeur n'est définie pour {1}",
+		"exactly_one_of":         "un seul champ parmi {0}, {1} doit être défini",
+		"at_least_one_of":        "au moins un champ parmi {0}, {1} doit être défini",
+		"all_or_none_of":         "{0}, {1} doivent être tous définis ou tous non définis",
+		"conflicts_with":         "{0} est incompatible avec {1}",
+	},
+}
+
+// defaultTranslations registers the messages of the go-playground validators.
+var defaultTranslations = map[string]func(*validator.Validate, ut.Translator) error{
+	"en": entranslations.RegisterDefaultTranslations,
+	"fr": frtranslations.RegisterDefaultTranslations,
+}
+
+// fieldPlaceholder is the name of the field in the messages built with a fieldTranslator.
+const fieldPlaceholder = "\x00field\x00"
+
+// fieldTranslator is the translator of a locale used to build the messages of the
+// FieldErrors. The go-playground and custom translations name the field with the
+// first parameter of T, validator.FieldError.Field (the Go name): fieldTranslator
+// replaces it with fieldPlaceholder, which is then replaced by the JSON name.
+type fieldTranslator struct {
+	ut.Translator
+}
+
+// T implements ut.Translator.
+func (t fieldTranslator) T(key any, params ...string) (string, error) {
+	if len(params) > 0 {
+		params = append([]string{fieldPlaceholder}, params[1:]...)
+	}
+
+	return t.Translator.T(key, params...)
+}
+
+// Add implements ut.Translator. The messages are already added by the translator
+// of the locale: they are added again with the same text.
+func (t fieldTranslator) Add(key any, text string, _ bool) error {
+	return t.Translator.Add(key, text, true)
+}
+
+// AddCardinal implements ut.Translator (see Add).
+func (t fieldTranslator) AddCardinal(key any, text string, rule locales.PluralRule, _ bool) error {
+	return t.Translator.AddCardinal(key, text, rule, true)
+}
+
+// AddOrdinal implements ut.Translator (see Add).
+func (t fieldTranslator) AddOrdinal(key any, text string, rule locales.PluralRule, _ bool) error {
+	return t.Translator.AddOrdinal(key, text, rule, true)
+}
+
+// AddRange implements ut.Translator (see Add).
+func (t fieldTranslator) AddRange(key any, text string, rule locales.PluralRule, _ bool) error {
+	return t.Translator.AddRange(key, text, rule, true)
+}
+
+// newUniversalTranslator returns the translator of the supported locales
+// with the messages of the go-playground and custom validators registered,
+// and the fieldTranslators of the locales.
+func newUniversalTranslator(v *validator.Validate) (*ut.UniversalTranslator, map[string]ut.Translator, error) {
+	uni := ut.New(en.New(), en.New(), fr.New())
+	fieldTranslators := make(map[string]ut.Translator, len(defaultTranslations))
+
+	for locale, register := range defaultTranslations {
+		trans, _ := uni.GetTranslator(locale)
+		fieldTranslators[locale] = fieldTranslator{trans}
+		translators := []ut.Translator{trans, fieldTranslators[locale]}
+
+		for _, t := range translators {
+			if err := register(v, t); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		for key, msg := range messages[locale] {
+			if err := trans.Add(key, msg, true); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		for _, cv := range customValidators {
+			for _, t := range translators {
+				if err := v.RegisterTranslation(cv.Key, t, func(ut.Translator) error { return nil }, translate); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+	}
+
+	return uni, fieldTranslators, nil
+}
+
+// translate returns the message of a custom validator failure in the language of the translator.
+func translate(trans ut.Translator, fe validator.FieldError) string {
+	key, param := fe.Tag(), fe.Param()
+
+	switch key {
+	case URN.Key, URNReference.Key:
+		// The parameter is a list of URN types.
+		types := strings.Fields(param)
+		or, _ := trans.T("or")
+		param = strings.Join(types, or)
+		if len(types) == 0 || strings.EqualFold(param, "any") {
+			key += "_any"
+		}
+	case RequireIfNull.Key, ExcludeIfNull.Key:
+		return conditionalMessage(trans, fe, strings.Fields(param))
+	case ExactlyOneOf.Key, AtLeastOneOf.Key, AllOrNoneOf.Key, ConflictsWith.Key:
+		return groupMessage(trans, fe, strings.Fields(param))
+	}
+
+	if msg, err := trans.T(key, fe.Field(), param); err == nil {
+		return msg
+	}
+
+	return defaultMessage(trans, fe)
+}
+
+// conditionalMessage returns the message of a failure of a rule depending on
+// other fields (e.g. required_if_null) with the referenced fields.
+func conditionalMessage(trans ut.Translator, fe validator.FieldError, fields []string) string {
+	or, _ := trans.T("or")
+	if msg, err := trans.T(fe.Tag(), fe.Field(), strings.Join(fields, or)); err == nil {
+		return msg
+	}
+
+	return defaultMessage(trans, fe)
+}
+
+// groupMessage returns the message of a failure of a group rule (e.g. exactly_one_of)
+// with the other fields of the group.
+func groupMessage(trans ut.Translator, fe validator.FieldError, fields []string) string {
+	if msg, err := trans.T(fe.Tag(), fe.Field(), strings.Join(fields, ", ")); err == nil {
+		return msg
+	}
+
+	return defaultMessage(trans, fe)
+}
+
+// defaultMessage returns the message of a failure without translation.
+func defaultMessage(trans ut.Translator, fe validator.FieldError) string {
+	msg, _ := trans.T("default", fe.Field(), fe.Tag())
+	return msg
+}
+
+// jsonName returns the JSON name of the field or its Go name if it has none.
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+
+	return name
+}
@@ -0,0 +1,42 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package validators
+
+import "testing"
+
+func TestMessages(t *testing.T) {
+	for locale, msgs := range messages {
+		for _, cv := range customValidators {
+			if msgs[cv.Key] == "" {
+				t.Errorf("no %s message for the validator %s", locale, cv.Key)
+			}
+		}
+		for key := range messages[DefaultLocale] {
+			if msgs[key] == "" {
+				t.Errorf("no %s message for the key %s", locale, key)
+			}
+		}
+	}
+
+	for locale := range defaultTranslations {
+		if _, ok := messages[locale]; !ok {
+			t.Errorf("no messages for the locale %s", locale)
+		}
+	}
+}
@@ -19,22 +19,149 @@
 package validators
 
 import (
+	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
 
+	"github.com/orange-cloudavenue/common-go/regex"
 	"github.com/orange-cloudavenue/common-go/urn"
 )
 
-// URN is a validator that checks if a string is a valid URN (Uniform Resource Name).
+// URN is a validator that checks if a value is a valid URN (see urn.Parse).
+// The parameter is the list of the allowed URN types separated by spaces,
+// e.g. "vdc vdcGroup". The type "any" allows any registered type and the keyword
+// "uuid" also accepts bare UUIDs. The types cannot be separated by "|", the OR
+// operator of the validator.
+// The value can be a string, a urn.URN, a urn.Of, a pointer to them or a slice of them.
 var URN = &CustomValidator{
 	Key: "urn",
 	Func: func(fl validator.FieldLevel) bool {
-		u, err := urn.FindURNTypeFromString(fl.Param())
-		if err != nil {
+		p, ok := parseURNParam(fl.Param())
+		if !ok {
 			return false
 		}
 
-		return strings.Contains(fl.Field().String(), u.String())
+		return p.validate(fl.Field())
 	},
 }
+
+// urnParam is the parsed parameter of the urn validator.
+type urnParam struct {
+	// types are the allowed URN types. Any registered type is allowed if empty.
+	types []urn.URN
+	// bareUUID accepts the values that are a UUID.
+	bareUUID bool
+}
+
+// parseURNParam parses the parameter of the urn validator.
+// Returns false if a type is unknown or if no type is given.
+func parseURNParam(param string) (urnParam, bool) {
+	var (
+		p       urnParam
+		anyType bool
+	)
+
+	for _, name := range strings.Fields(param) {
+		switch strings.ToLower(name) {
+		case "any":
+			anyType = true
+		case "uuid":
+			p.bareUUID = true
+		default:
+			u, err := urn.FindURNTypeFromString(name)
+			if err != nil {
+				return urnParam{}, false
+			}
+			p.types = append(p.types, u)
+		}
+	}
+
+	if anyType {
+		p.types = nil
+	} else if len(p.types) == 0 {
+		return urnParam{}, false
+	}
+
+	return p, true
+}
+
+// validate returns true if the value is a valid URN of one of the allowed types.
+func (p urnParam) validate(v reflect.Value) bool {
+	if v.CanInterface() {
+		if u, ok := v.Interface().(interface{ URN() urn.URN }); ok {
+			return p.validateString(u.URN().String())
+		}
+	}
+
+	switch v.Kind() { //nolint:exhaustive // other kinds are not valid URNs
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return false
+		}
+		return p.validate(v.Elem())
+	case reflect.String:
+		return p.validateString(v.String())
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			if !p.validate(v.Index(i)) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+func (p urnParam) validateString(value string) bool {
+	if p.bareUUID && regex.IsUUID(value, regex.UUIDDefault) {
+		return true
+	}
+
+	parsed, err := urn.Parse(value)
+	if err != nil {
+		return false
+	}
+
+	return len(p.types) == 0 || slices.Contains(p.types, parsed.Type)
+}
+
+// URNReference is a validator that checks if a urn.Reference or a urn.ReferenceOf has a
+// valid ID of one of the given URN types. The parameter has the syntax of the URN validator
+// (e.g. "vdc vdcGroup"). Without parameter, the ID can be of any registered URN type.
+var URNReference = &CustomValidator{
+	Key: "urn_reference",
+	Func: func(fl validator.FieldLevel) bool {
+		ref, ok := reference(fl.Field())
+		if !ok {
+			return false
+		}
+
+		p := urnParam{}
+		if fl.Param() != "" {
+			if p, ok = parseURNParam(fl.Param()); !ok {
+				return false
+			}
+		}
+
+		return p.validateString(ref.ID.String())
+	},
+}
+
+// reference returns the urn.Reference of a urn.Reference or a urn.ReferenceOf.
+func reference(v reflect.Value) (urn.Reference, bool) {
+	if !v.CanInterface() {
+		return urn.Reference{}, false
+	}
+
+	switch ref := v.Interface().(type) {
+	case urn.Reference:
+		return ref, true
+	case interface{ Reference() urn.Reference }:
+		return ref.Reference(), true
+	default:
+		return urn.Reference{}, false
+	}
+}
@@ -23,44 +23,134 @@ import (
 	"errors"
 	"reflect"
 
+	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
 )
 
 type Validator struct {
 	*validator.Validate
+
+	uni *ut.UniversalTranslator
+	// trans is the fieldTranslator of the locale and fieldTranslators those of the
+	// supported locales, used to build the messages of the FieldErrors.
+	trans            ut.Translator
+	fieldTranslators map[string]ut.Translator
+	// groups are the group rules registered by RegisterGroup.
+	groups map[reflect.Type][]group
+	// structLevels are the struct-level validations registered by RegisterStructValidation,
+	// run with the group rules of the same type.
+	structLevels map[reflect.Type]validator.StructLevelFuncCtx
 }
 
-// New creates a new validator.
-func New() *Validator {
+// Option is an option of New.
+type Option func(*options)
+
+type options struct {
+	locale string
+}
+
+// WithLocale sets the locale of the error messages (e.g. "en" or "fr").
+// An unsupported locale falls back to DefaultLocale.
+func WithLocale(locale string) Option {
+	return func(o *options) {
+		o.locale = locale
+	}
+}
+
+// customValidators are the validators of the package registered by New.
+var customValidators = []*CustomValidator{
 	// * String
-	v := validator.New(validator.WithRequiredStructEnabled())
-	_ = v.RegisterValidation(DisallowUpper.Key, DisallowUpper.Func)
-	_ = v.RegisterValidation(DisallowSpace.Key, DisallowSpace.Func)
-	_ = v.RegisterValidation(Case.Key, Case.Func)
+	DisallowUpper,
+	DisallowSpace,
+	Case,
 
 	// * Key/Value
-	_ = v.RegisterValidation(KeyValue.Key, KeyValue.Func)
+	KeyValue,
 
 	// * Cloud Avenue
-	_ = v.RegisterValidation(URN.Key, URN.Func)
-	_ = v.RegisterValidation(CAVResourceName.Key, CAVResourceName.Func)
+	URN,
+	URNReference,
+	CAVResourceName,
 
 	// * Network
-	_ = v.RegisterValidation(IPV4Range.Key, IPV4Range.Func)
-	_ = v.RegisterValidation(TCPUDPPort.Key, TCPUDPPort.Func)
-	_ = v.RegisterValidation(TCPUDPPortRange.Key, TCPUDPPortRange.Func)
+	IPV4Range,
+	IPV6Range,
+	IPRange,
+	CIDRPrefix,
+	IPInCIDR,
+	RangeInCIDR,
+	PrivateIP,
+	NoOverlap,
+	TCPUDPPort,
+	TCPUDPPortRange,
 
 	// * HTTP
-	_ = v.RegisterValidation(HTTPStatusCode.Key, HTTPStatusCode.Func)
-	_ = v.RegisterValidation(HTTPStatusCodeRange.Key, HTTPStatusCodeRange.Func)
+	HTTPStatusCode,
+	HTTPStatusCodeRange,
 
 	// * Require/Exclude
-	_ = v.RegisterValidation(RequireIfNull.Key, RequireIfNull.Func)
-	_ = v.RegisterValidation(ExcludeIfNull.Key, ExcludeIfNull.Func)
+	RequireIfNull,
+	ExcludeIfNull,
+
+	// * Groups
+	ExactlyOneOf,
+	AtLeastOneOf,
+	AllOrNoneOf,
+	ConflictsWith,
+}
+
+// New creates a new validator.
+// The errors of Struct and StructCtx are ValidationErrors whose paths use the JSON
+// names of the fields and whose messages are in the language set by WithLocale.
+// They can also be read as go-playground validator.ValidationErrors, which keep
+// the Go names of the fields.
+func New(opts ...Option) *Validator {
+	o := options{locale: DefaultLocale}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	v := validator.New(validator.WithRequiredStructEnabled())
+	for _, cv := range customValidators {
+		_ = v.RegisterValidation(cv.Key, cv.Func, cv.CallEvenIfNull)
+	}
+
+	uni, fieldTranslators, err := newUniversalTranslator(v)
+	if err != nil {
+		// The messages are static, a failure is a bug of the package.
+		panic(err)
+	}
+	trans, _ := uni.GetTranslator(o.locale)
 
 	return &Validator{
-		Validate: v,
+		Validate:         v,
+		uni:              uni,
+		trans:            fieldTranslators[trans.Locale()],
+		fieldTranslators: fieldTranslators,
+	}
+}
+
+// Translator returns the translator of the locale (see ValidationErrors.Translate).
+// An unsupported locale falls back to DefaultLocale.
+func (v *Validator) Translator(locale string) ut.Translator {
+	trans, _ := v.uni.GetTranslator(locale)
+	return trans
+}
+
+// RegisterTranslation registers a translation of the rule tag, like the go-playground
+// RegisterTranslation, which is also used by the messages of the FieldErrors when
+// trans is returned by Translator.
+func (v *Validator) RegisterTranslation(tag string, trans ut.Translator, registerFn validator.RegisterTranslationsFunc, translationFn validator.TranslationFunc) error {
+	if err := v.Validate.RegisterTranslation(tag, trans, registerFn, translationFn); err != nil {
+		return err
 	}
+
+	ft, ok := v.fieldTranslators[trans.Locale()]
+	if !ok {
+		return nil
+	}
+
+	return v.Validate.RegisterTranslation(tag, ft, registerFn, translationFn)
 }
 
 func (v *Validator) Struct(s interface{}) error {
@@ -73,7 +163,7 @@ func (v *Validator) Struct(s interface{}) error {
 	}
 
 	if err := v.Validate.Struct(s); err != nil {
-		return err
+		return newValidationErrors(err, s, v.trans, v.fieldTranslators)
 	}
 
 	return nil
@@ -89,8 +179,44 @@ func (v *Validator) StructCtx(ctx context.Context, s interface{}) error {
 	}
 
 	if err := v.Validate.StructCtx(ctx, s); err != nil {
-		return err
+		return newValidationErrors(err, s, v.trans, v.fieldTranslators)
 	}
 
 	return nil
 }
+
+// RegisterStructValidation registers a struct-level validation on the types, like the
+// go-playground RegisterStructValidation, along with the group rules registered with
+// RegisterGroup on the same types. A validation registered directly on the embedded
+// go-playground Validate replaces the group rules of the type.
+func (v *Validator) RegisterStructValidation(fn validator.StructLevelFunc, types ...interface{}) {
+	v.RegisterStructValidationCtx(func(_ context.Context, sl validator.StructLevel) { fn(sl) }, types...)
+}
+
+// RegisterStructValidationCtx is RegisterStructValidation with a context.Context
+// (see the go-playground RegisterStructValidationCtx).
+func (v *Validator) RegisterStructValidationCtx(fn validator.StructLevelFuncCtx, types ...interface{}) {
+	if v.structLevels == nil {
+		v.structLevels = make(map[reflect.Type]validator.StructLevelFuncCtx)
+	}
+
+	for _, t := range types {
+		typ := indirect(reflect.TypeOf(t))
+		v.structLevels[typ] = fn
+		v.registerStructLevel(typ)
+	}
+}
+
+// registerStructLevel registers validateStructLevel on the struct type.
+func (v *Validator) registerStructLevel(t reflect.Type) {
+	v.Validate.RegisterStructValidationCtx(v.validateStructLevel, reflect.New(t).Interface())
+}
+
+// validateStructLevel runs the struct-level validation and the group rules of the struct type.
+func (v *Validator) validateStructLevel(ctx context.Context, sl validator.StructLevel) {
+	if fn, ok := v.structLevels[sl.Current().Type()]; ok {
+		fn(ctx, sl)
+	}
+
+	v.validateGroups(sl)
+}
@@ -23,9 +23,26 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/orange-cloudavenue/common-go/urn"
 	"github.com/orange-cloudavenue/common-go/validators"
 )
 
+// assertRule asserts that err is a validators.ValidationErrors with a failure of the rule.
+func assertRule(t *testing.T, err error, rule string, msgAndArgs ...any) {
+	t.Helper()
+
+	var errs validators.ValidationErrors
+	if !assert.ErrorAs(t, err, &errs, msgAndArgs...) {
+		return
+	}
+	for _, fe := range errs {
+		if fe.Rule == rule {
+			return
+		}
+	}
+	assert.Fail(t, "no failure of the rule "+rule, msgAndArgs...)
+}
+
 func TestCustomValidators(t *testing.T) {
 	t.Parallel()
 	testsCase := map[string]struct {
@@ -55,9 +72,49 @@ func TestCustomValidators(t *testing.T) {
 		},
 		"urn": {
 			valuesWork:        []any{"urn:vcloud:gateway:4aeb40d8-038c-4e77-8181-a7054f583b12"},
-			valuesDoesNotWork: []any{"urn:vcloud:vm:invalid"},
+			valuesDoesNotWork: []any{"urn:vcloud:vm:invalid", "urn:vcloud:gateway:not-a-uuid", "garbage urn:vcloud:gateway: garbage", "urn:vcloud:gateway:4aeb40d8-038c-4e77-8181-a7054f583b12 garbage", 42},
 			rule:              "urn=edgegateway",
 		},
+		"urn-multiple-types": {
+			valuesWork:        []any{"urn:vcloud:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12", "urn:vcloud:vdcGroup:4aeb40d8-038c-4e77-8181-a7054f583b12"},
+			valuesDoesNotWork: []any{"urn:vcloud:vm:4aeb40d8-038c-4e77-8181-a7054f583b12", "4aeb40d8-038c-4e77-8181-a7054f583b12"},
+			rule:              "urn=vdc vdcGroup",
+		},
+		"urn-any": {
+			valuesWork:        []any{"urn:vcloud:vm:4aeb40d8-038c-4e77-8181-a7054f583b12", "urn:cloudavenue:vcda:4aeb40d8-038c-4e77-8181-a7054f583b12"},
+			valuesDoesNotWork: []any{"urn:vcloud:unknown:4aeb40d8-038c-4e77-8181-a7054f583b12", "urn:vcloud:vm:invalid", "4aeb40d8-038c-4e77-8181-a7054f583b12"},
+			rule:              "urn=any",
+		},
+		"urn-bare-uuid": {
+			valuesWork:        []any{"urn:vcloud:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12", "4aeb40d8-038c-4e77-8181-a7054f583b12"},
+			valuesDoesNotWork: []any{"urn:vcloud:vm:4aeb40d8-038c-4e77-8181-a7054f583b12", "4aeb40d8-038c-4e77-8181"},
+			rule:              "urn=vdc uuid",
+		},
+		"urn-without-type": {
+			valuesWork:        []any{},
+			valuesDoesNotWork: []any{"4aeb40d8-038c-4e77-8181-a7054f583b12"},
+			rule:              "urn=uuid",
+		},
+		"urn-entity": {
+			valuesWork:        []any{"urn:vcloud:entity:vmware:tkgcluster:4aeb40d8-038c-4e77-8181-a7054f583b12"},
+			valuesDoesNotWork: []any{"urn:vcloud:vm:4aeb40d8-038c-4e77-8181-a7054f583b12"},
+			rule:              "urn=entity",
+		},
+		"urn-entity-type": {
+			valuesWork:        []any{"urn:vcloud:type:vmware:tkgcluster:1.0.0"},
+			valuesDoesNotWork: []any{"urn:vcloud:entity:vmware:tkgcluster:4aeb40d8-038c-4e77-8181-a7054f583b12"},
+			rule:              "urn=entityType",
+		},
+		"urn-ip-set": {
+			valuesWork:        []any{"urn:vcloud:firewallGroup:4aeb40d8-038c-4e77-8181-a7054f583b12"},
+			valuesDoesNotWork: []any{"urn:vcloud:network:4aeb40d8-038c-4e77-8181-a7054f583b12"},
+			rule:              "urn=ipSet",
+		},
+		"urn-external-network": {
+			valuesWork:        []any{"urn:vcloud:network:4aeb40d8-038c-4e77-8181-a7054f583b12"},
+			valuesDoesNotWork: []any{"urn:vcloud:providervdc:4aeb40d8-038c-4e77-8181-a7054f583b12"},
+			rule:              "urn=externalNetwork",
+		},
 		"urn-bad": {
 			valuesWork:        []any{},
 			valuesDoesNotWork: []any{"urn:vcloud:gateway:4aeb40d8-038c-4e77-8181-a7054f583b12"},
@@ -68,6 +125,52 @@ func TestCustomValidators(t *testing.T) {
 			valuesDoesNotWork: []any{"192.168.0.256-192.168.0.300", "192.168.0.256", "192.168.0.100-192.168.0.1"},
 			rule:              "ipv4_range",
 		},
+		"ipv6_range": {
+			valuesWork:        []any{"2001:db8::1-2001:db8::ff", "fd00::-fd00::1:0"},
+			valuesDoesNotWork: []any{"192.168.0.1-192.168.0.100", "2001:db8::ff-2001:db8::1", "2001:db8::1", "fe80::1%eth0-fe80::2%eth0", "2001:db8::1-192.168.0.1"},
+			rule:              "ipv6_range",
+		},
+		"ip_range": {
+			valuesWork:        []any{"192.168.0.1-192.168.0.100", "2001:db8::1-2001:db8::ff"},
+			valuesDoesNotWork: []any{"192.168.0.1-2001:db8::ff", "192.168.0.1-192.168.0.1", "192.168.0.1", "invalid-range", ""},
+			rule:              "ip_range",
+		},
+		"cidr_prefix": {
+			valuesWork:        []any{"192.168.0.0/16", "192.168.1.0/24", "10.0.0.0/28", "2001:db8::/24"},
+			valuesDoesNotWork: []any{"10.0.0.0/8", "10.0.0.0/29", "192.168.0.1", "invalid", ""},
+			rule:              "cidr_prefix=16-28",
+		},
+		"cidr_prefix-exact": {
+			valuesWork:        []any{"192.168.1.0/24"},
+			valuesDoesNotWork: []any{"192.168.0.0/16", "192.168.1.0/25"},
+			rule:              "cidr_prefix=24",
+		},
+		"cidr_prefix-invalid-param": {
+			valuesWork:        []any{},
+			valuesDoesNotWork: []any{"192.168.1.0/24"},
+			rule:              "cidr_prefix=28-16",
+		},
+		"private_ip": {
+			valuesWork:        []any{"10.0.0.1", "172.16.0.1", "192.168.0.1", "fd00::1"},
+			valuesDoesNotWork: []any{"8.8.8.8", "172.32.0.1", "2001:db8::1", "192.168.0.0/24", "invalid", ""},
+			rule:              "private_ip",
+		},
+		"no_overlap": {
+			valuesWork: []any{
+				[]string{},
+				[]string{"192.168.0.0/24", "192.168.1.0/24", "192.168.2.1-192.168.2.100"},
+				[]string{"10.0.0.0/8", "2001:db8::/32", "::ffff:10.0.0.1-::ffff:10.0.0.2"},
+			},
+			valuesDoesNotWork: []any{
+				[]string{"192.168.0.0/16", "192.168.1.0/24"},
+				[]string{"192.168.0.1-192.168.0.100", "192.168.0.100-192.168.0.200"},
+				[]string{"192.168.0.0/24", "192.168.0.255-192.168.1.10"},
+				[]string{"2001:db8::/32", "2001:db8:1::1-2001:db8:1::ff"},
+				[]string{"192.168.0.0/24", "invalid"},
+				"192.168.0.0/24",
+			},
+			rule: "no_overlap",
+		},
 		"tcp_udp_port": {
 			valuesWork:        []any{"80", 80, "65535", 65535},
 			valuesDoesNotWork: []any{"-1", "65536", "invalid", 65536, "", 0},
@@ -139,6 +242,97 @@ func TestCustomValidators(t *testing.T) {
 	}
 }
 
+func TestURNField(t *testing.T) {
+	t.Parallel()
+	const (
+		vdcID   = "urn:vcloud:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12"
+		groupID = "urn:vcloud:vdcGroup:4aeb40d8-038c-4e77-8181-a7054f583b12"
+		vmID    = "urn:vcloud:vm:4aeb40d8-038c-4e77-8181-a7054f583b12"
+	)
+	type network struct {
+		Owner    urn.URN             `validate:"urn=vdc vdcGroup"`
+		Previous *string             `validate:"omitempty,urn=vdc"`
+		VMs      []string            `validate:"urn=vm"`
+		Backups  []*string           `validate:"omitempty,urn=vm"`
+		VDC      urn.Of[urn.VDCKind] `validate:"urn=vdc"`
+		Parents  []urn.URN           `validate:"omitempty,urn=any"`
+		Legacy   string              `validate:"omitempty,urn=vdc uuid"`
+	}
+
+	v := validators.New()
+	vm := vmID
+	valid := network{
+		Owner:   groupID,
+		VMs:     []string{vmID, vmID},
+		Backups: []*string{&vm},
+		VDC:     urn.MustFrom[urn.VDCKind](vdcID),
+		Parents: []urn.URN{vdcID, groupID},
+		Legacy:  "4aeb40d8-038c-4e77-8181-a7054f583b12",
+	}
+	assert.NoError(t, v.Struct(&valid))
+
+	tests := map[string]func(*network){
+		"OwnerUnexpectedType": func(n *network) { n.Owner = vmID },
+		"PreviousInvalid":     func(n *network) { s := "urn:vcloud:vdc:invalid"; n.Previous = &s },
+		"VMsOneInvalid":       func(n *network) { n.VMs = []string{vmID, vdcID} },
+		"BackupsNil":          func(n *network) { n.Backups = []*string{nil} },
+		"ParentsUnknownType":  func(n *network) { n.Parents = []urn.URN{"urn:vcloud:unknown:4aeb40d8-038c-4e77-8181-a7054f583b12"} },
+		"LegacyInvalidUUID":   func(n *network) { n.Legacy = "4aeb40d8-038c-4e77-8181" },
+	}
+	for name, mutate := range tests {
+		invalid := valid
+		mutate(&invalid)
+		assertRule(t, v.Struct(&invalid), "urn", name)
+	}
+}
+
+func TestURNReferenceField(t *testing.T) {
+	t.Parallel()
+	type edgeGateway struct {
+		Name     string         `validate:"required"`
+		Owner    urn.Reference  `validate:"urn_reference=vdc"`
+		Template *urn.Reference `validate:"omitempty,urn_reference=vappTemplate"`
+	}
+
+	v := validators.New()
+	valid := edgeGateway{
+		Name:  "edge",
+		Owner: urn.NewReference("my-vdc", "urn:vcloud:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12"),
+	}
+	assert.NoError(t, v.Struct(&valid))
+
+	invalid := valid
+	invalid.Owner = urn.NewReference("my-group", "urn:vcloud:vdcGroup:4aeb40d8-038c-4e77-8181-a7054f583b12")
+	assertRule(t, v.Struct(&invalid), "urn_reference")
+
+	invalid = valid
+	invalid.Template = &urn.Reference{ID: "urn:vcloud:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12"}
+	assertRule(t, v.Struct(&invalid), "urn_reference")
+
+	invalid = valid
+	invalid.Owner = urn.NewReference("my-vdc", "urn:vcloud:vdc:invalid")
+	assertRule(t, v.Struct(&invalid), "urn_reference")
+
+	invalid = valid
+	invalid.Owner = urn.Reference{}
+	assertRule(t, v.Struct(&invalid), "urn_reference")
+
+	type ownerReference struct {
+		Owner urn.Reference                `validate:"urn_reference=vdc vdcGroup"`
+		VDC   urn.ReferenceOf[urn.VDCKind] `validate:"urn_reference=vdc vdcGroup"`
+	}
+	vdc := urn.ReferenceOf[urn.VDCKind]{Name: "my-vdc", ID: urn.MustFrom[urn.VDCKind]("urn:vcloud:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12")}
+	assert.NoError(t, v.Struct(&ownerReference{Owner: urn.NewReference("my-group", "urn:vcloud:vdcGroup:4aeb40d8-038c-4e77-8181-a7054f583b12"), VDC: vdc}))
+	assertRule(t, v.Struct(&ownerReference{Owner: urn.NewReference("my-vm", "urn:vcloud:vm:4aeb40d8-038c-4e77-8181-a7054f583b12"), VDC: vdc}), "urn_reference")
+	assertRule(t, v.Struct(&ownerReference{Owner: urn.NewReference("my-vdc", "urn:vcloud:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12")}), "urn_reference")
+
+	type anyReference struct {
+		Ref urn.Reference `validate:"urn_reference"`
+	}
+	assert.NoError(t, v.Struct(&anyReference{Ref: urn.NewReference("my-vm", "urn:vcloud:vm:4aeb40d8-038c-4e77-8181-a7054f583b12")}))
+	assert.Error(t, v.Struct(&anyReference{Ref: urn.NewReference("unknown", "urn:vcloud:unknown:4aeb40d8-038c-4e77-8181-a7054f583b12")}))
+}
+
 func TestDefaulter(t *testing.T) {
 	t.Parallel()
 	type defaultTest struct {